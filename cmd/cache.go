@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/jdk-manager/internal/cache"
+	"github.com/jdk-manager/internal/config"
+	"github.com/jdk-manager/internal/httpclient"
+	"github.com/jdk-manager/internal/jdk"
+)
+
+// metadataCache caches distribution API responses. It is shared by all providers
+// of a run so that list-remote can tell how old the data it shows is.
+var metadataCache *cache.Transport
+
+// apiHTTPClient returns the HTTP client used for distribution APIs. Responses are
+// cached under ~/.jdks/cache/http according to the config and --refresh/--offline.
+func apiHTTPClient(cfg *config.Config) (*http.Client, error) {
+	if metadataCache == nil {
+		if refreshFlag && offlineFlag {
+			return nil, fmt.Errorf("--refresh and --offline cannot be used together")
+		}
+
+		ttl := cache.DefaultTTL
+		if cfg.CacheTTL != "" {
+			parsed, err := time.ParseDuration(cfg.CacheTTL)
+			if err != nil || parsed < 0 {
+				return nil, fmt.Errorf("invalid cache TTL %q: expected a duration such as 6h", cfg.CacheTTL)
+			}
+			ttl = parsed
+		}
+
+		mode := cache.ModeDefault
+		switch {
+		case refreshFlag:
+			mode = cache.ModeRefresh
+		case offlineFlag:
+			mode = cache.ModeOffline
+		}
+
+		manager, err := jdk.NewManager()
+		if err != nil {
+			return nil, err
+		}
+
+		metadataCache = cache.New(filepath.Join(manager.GetJDKsDir(), "cache", "http"), ttl, mode, httpclient.Transport())
+	}
+
+	return &http.Client{Transport: metadataCache}, nil
+}
+
+// formatAge renders a duration the way it is shown in cache notes, e.g. "3 hours ago"
+func formatAge(age time.Duration) string {
+	plural := func(n int, unit string) string {
+		if n == 1 {
+			return fmt.Sprintf("1 %s ago", unit)
+		}
+		return fmt.Sprintf("%d %ss ago", n, unit)
+	}
+
+	switch {
+	case age < time.Minute:
+		return "just now"
+	case age < time.Hour:
+		return plural(int(age/time.Minute), "minute")
+	case age < 48*time.Hour:
+		return plural(int(age/time.Hour), "hour")
+	default:
+		return plural(int(age/(24*time.Hour)), "day")
+	}
+}
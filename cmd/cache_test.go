@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatAge(t *testing.T) {
+	tests := []struct {
+		age      time.Duration
+		expected string
+	}{
+		{10 * time.Second, "just now"},
+		{time.Minute, "1 minute ago"},
+		{45 * time.Minute, "45 minutes ago"},
+		{3*time.Hour + 20*time.Minute, "3 hours ago"},
+		{47 * time.Hour, "47 hours ago"},
+		{72 * time.Hour, "3 days ago"},
+	}
+
+	for _, test := range tests {
+		if result := formatAge(test.age); result != test.expected {
+			t.Errorf("formatAge(%v) = %q, expected %q", test.age, result, test.expected)
+		}
+	}
+}
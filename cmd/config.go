@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/jdk-manager/internal/config"
+	"github.com/jdk-manager/internal/httpclient"
+	"github.com/jdk-manager/internal/jdk"
+	"github.com/mitchellh/go-homedir"
+)
+
+// loadConfig reads the config file and environment, then applies command line overrides
+func loadConfig() (*config.Config, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	if apiURLFlag != "" {
+		cfg.AdoptiumAPI = apiURLFlag
+	}
+	if downloadHostFlag != "" {
+		cfg.DownloadHost = downloadHostFlag
+	}
+	if len(mirrorFlags) > 0 {
+		cfg.Mirrors = mirrorFlags
+	}
+	if proxyFlag != "" {
+		cfg.Proxy = proxyFlag
+	}
+	if caBundleFlag != "" {
+		cfg.CABundle = caBundleFlag
+	}
+
+	settings, err := httpSettings(cfg)
+	if err != nil {
+		return nil, err
+	}
+	httpclient.Configure(settings)
+
+	return cfg, nil
+}
+
+// httpSettings returns the retry and timeout settings of the shared HTTP transport
+func httpSettings(cfg *config.Config) (httpclient.Settings, error) {
+	settings := httpclient.DefaultSettings()
+
+	if cfg.HTTPRetries != nil {
+		if *cfg.HTTPRetries < 0 {
+			return settings, fmt.Errorf("invalid http_retries %d: must not be negative", *cfg.HTTPRetries)
+		}
+		settings.MaxRetries = *cfg.HTTPRetries
+	}
+
+	if cfg.HTTPTimeout != "" {
+		timeout, err := time.ParseDuration(cfg.HTTPTimeout)
+		if err != nil || timeout <= 0 {
+			return settings, fmt.Errorf("invalid http_timeout %q: expected a duration such as 60s", cfg.HTTPTimeout)
+		}
+		settings.IdleTimeout = timeout
+	}
+
+	if cfg.HTTPBackoff != "" {
+		backoff, err := time.ParseDuration(cfg.HTTPBackoff)
+		if err != nil || backoff < 0 {
+			return settings, fmt.Errorf("invalid http_backoff %q: expected a duration such as 1s", cfg.HTTPBackoff)
+		}
+		settings.InitialBackoff = backoff
+	}
+
+	if cfg.Proxy != "" {
+		proxy, err := proxyURL(cfg)
+		if err != nil {
+			return settings, err
+		}
+		settings.Proxy = proxy
+		settings.NoProxy = cfg.NoProxy
+	}
+
+	if cfg.CABundle != "" {
+		caBundle, err := homedir.Expand(cfg.CABundle)
+		if err != nil {
+			return settings, err
+		}
+		pool, err := httpclient.LoadCABundle(caBundle)
+		if err != nil {
+			return settings, err
+		}
+		settings.RootCAs = pool
+	}
+
+	return settings, nil
+}
+
+// lockTimeout returns how long to wait for another jdk process working on a version
+func lockTimeout(cfg *config.Config) (time.Duration, error) {
+	if cfg.LockTimeout == "" {
+		return jdk.DefaultLockTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(cfg.LockTimeout)
+	if err != nil || timeout < 0 {
+		return 0, fmt.Errorf("invalid lock_timeout %q: expected a duration such as 10m", cfg.LockTimeout)
+	}
+	return timeout, nil
+}
+
+// proxyURL parses the configured proxy, adding the configured credentials
+func proxyURL(cfg *config.Config) (*url.URL, error) {
+	proxy, err := url.Parse(cfg.Proxy)
+	if err != nil || proxy.Host == "" {
+		return nil, fmt.Errorf("invalid proxy %q: expected a URL such as http://proxy.example.com:3128", cfg.Proxy)
+	}
+
+	switch proxy.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q (expected http, https or socks5)", proxy.Scheme)
+	}
+
+	if cfg.ProxyUsername != "" {
+		proxy.User = url.UserPassword(cfg.ProxyUsername, cfg.ProxyPassword)
+	}
+
+	return proxy, nil
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/jdk-manager/internal/jdk"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export <version>",
+	Short: "Copy an installed JDK into a bundle directory",
+	Long: `Copy an installed JDK to a directory of your choice, e.g. to assemble a
+distribution bundle. Use --os, --arch and --libc to export a build installed
+for another platform with 'jdk install --os ... --arch ...'.
+
+Examples:
+  jdk export 21 --output dist/jdk                          # JDK 21 for this system
+  jdk export 21 --os windows --arch x64 --output dist/jdk  # JDK 21 for Windows x64`,
+	Args: cobra.ExactArgs(1),
+	Run:  runExport,
+}
+
+var (
+	exportOutput string
+	exportTarget platformFlags
+)
+
+func init() {
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "Directory to create with the JDK contents")
+	exportCmd.MarkFlagRequired("output")
+	addPlatformFlags(exportCmd, &exportTarget)
+	addLibCFlag(exportCmd, &exportTarget)
+	rootCmd.AddCommand(exportCmd)
+}
+
+func runExport(cmd *cobra.Command, args []string) {
+	version := args[0]
+
+	platform, err := exportTarget.platform()
+	checkError(err)
+
+	manager, err := jdk.NewManagerForPlatform(platform)
+	checkError(err)
+
+	installed, err := manager.IsInstalled(version)
+	checkError(err)
+
+	if !installed {
+		fmt.Fprintf(os.Stderr, "Error: JDK %s is not installed for %s.\n", version, platform)
+		fmt.Fprintf(os.Stderr, "Install it with: jdk install %s%s\n", version, platformArgs(platform))
+		os.Exit(1)
+	}
+
+	checkError(manager.Export(version, exportOutput))
+
+	fmt.Printf("✓ JDK %s for %s exported to %s\n", version, platform, exportOutput)
+}
@@ -0,0 +1,350 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/config"
+	"github.com/jdk-manager/internal/jdk"
+	"github.com/jdk-manager/internal/provider"
+	"github.com/jdk-manager/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var infoCmd = &cobra.Command{
+	Use:   "info <version>",
+	Short: "Show details of a JDK release",
+	Long: `Show the exact build, release date, download size, checksum, release notes
+and supported platforms of a JDK release. Installed versions are described from
+the local install; use --remote to look them up in the distribution instead.
+
+Examples:
+  jdk info 21                     # Installed JDK 21, or the latest remote 21 release
+  jdk info 17.0.8 --remote        # Release details from the distribution
+  jdk info 21 --vendor corretto   # Amazon Corretto 21
+  jdk info 21 --json              # Machine readable output`,
+	Args: cobra.ExactArgs(1),
+	Run:  runInfo,
+}
+
+var (
+	infoVendor    string
+	infoImageType string
+	infoJSON      bool
+	infoRemote    bool
+	infoTarget    platformFlags
+)
+
+func init() {
+	infoCmd.Flags().StringVar(&infoImageType, "image-type", adoptium.ImageTypeJDK, "Image type: "+strings.Join(adoptium.ImageTypes, ", "))
+	infoCmd.Flags().BoolVar(&infoJSON, "json", false, "Print the details as JSON")
+	infoCmd.Flags().BoolVar(&infoRemote, "remote", false, "Look the version up in the distribution even if it is installed")
+	addVendorFlags(infoCmd, &infoVendor)
+	addPlatformFlags(infoCmd, &infoTarget)
+	addLibCFlag(infoCmd, &infoTarget)
+	rootCmd.AddCommand(infoCmd)
+}
+
+// releaseDetails is what 'jdk info' reports, and the schema of its --json output
+type releaseDetails struct {
+	Vendor         string   `json:"vendor,omitempty"`
+	Version        string   `json:"version"`
+	Build          int      `json:"build,omitempty"`
+	ReleaseName    string   `json:"release_name,omitempty"`
+	ReleaseDate    string   `json:"release_date,omitempty"`
+	ImageType      string   `json:"image_type"`
+	Platform       string   `json:"platform"`
+	Filename       string   `json:"filename,omitempty"`
+	Size           int64    `json:"size,omitempty"`
+	Checksum       string   `json:"checksum,omitempty"`
+	DownloadURL    string   `json:"download_url,omitempty"`
+	ReleaseNotes   string   `json:"release_notes,omitempty"`
+	ReleaseLink    string   `json:"release_link,omitempty"`
+	Platforms      []string `json:"platforms,omitempty"`
+	Implementor    string   `json:"implementor,omitempty"`
+	RuntimeVersion string   `json:"runtime_version,omitempty"`
+	Modules        []string `json:"modules,omitempty"`
+	Installed      bool     `json:"installed"`
+	InstallPath    string   `json:"install_path,omitempty"`
+	InstalledAt    string   `json:"installed_at,omitempty"`
+	DiskSize       int64    `json:"disk_size,omitempty"`
+}
+
+func runInfo(cmd *cobra.Command, args []string) {
+	version := args[0]
+
+	if !isValidImageType(infoImageType) {
+		checkError(fmt.Errorf("invalid image type: %s (expected one of %s)", infoImageType, strings.Join(adoptium.ImageTypes, ", ")))
+	}
+	platform, err := infoTarget.platform()
+	checkError(err)
+
+	cfg, err := loadConfig()
+	checkError(err)
+
+	jdkProvider, err := getProvider(cfg, infoVendor)
+	checkError(err)
+
+	manager, err := jdk.NewManagerForPlatform(platform)
+	checkError(err)
+
+	var details *releaseDetails
+	installName := jdk.InstallName(jdkProvider.Vendor().Name, version, infoImageType)
+	installed, err := manager.IsInstalled(installName)
+	checkError(err)
+
+	if installed && !infoRemote {
+		details, err = installedDetails(cfg, manager, installName)
+	} else {
+		details, err = remoteDetails(cmd.Context(), jdkProvider, adoptium.DownloadRequest{
+			Version:   version,
+			ImageType: infoImageType,
+			OS:        platform.OS,
+			Arch:      platform.Arch,
+			LibC:      platform.LibC,
+		})
+		if err == nil && installed {
+			details.Installed = true
+			details.InstallPath = filepath.Join(manager.GetJDKsDir(), installName)
+		}
+	}
+	checkError(err)
+
+	if infoJSON {
+		checkError(writeJSON(os.Stdout, details))
+		return
+	}
+
+	printDetails(details)
+}
+
+// remoteDetails looks a version up in the distribution
+func remoteDetails(ctx context.Context, jdkProvider provider.Provider, request adoptium.DownloadRequest) (*releaseDetails, error) {
+	downloadInfo, err := jdkProvider.GetDownloadInfo(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	details := &releaseDetails{
+		Vendor:      jdkProvider.Vendor().DisplayName,
+		Version:     formatVersionData(downloadInfo.Version),
+		Build:       downloadInfo.Version.Build,
+		ImageType:   downloadInfo.ImageType,
+		Platform:    downloadInfo.Platform.String(),
+		Filename:    downloadInfo.Filename,
+		Size:        downloadInfo.Size,
+		Checksum:    downloadInfo.Checksum,
+		DownloadURL: downloadInfo.URL,
+	}
+
+	// Some distributions only publish the checksum as a file next to the archive
+	if details.Checksum == "" && downloadInfo.ChecksumURL != "" {
+		checksum, err := utils.FetchChecksum(ctx, downloadInfo.ChecksumURL)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+		details.Checksum = checksum
+	}
+
+	releaseProvider, ok := jdkProvider.(provider.ReleaseProvider)
+	if !ok {
+		return details, nil
+	}
+
+	// The newest release of a version may not be built for the requested platform yet,
+	// so the release is looked up by the exact version the download was resolved to
+	releaseVersion := downloadInfo.Version.Version()
+	releaseVersion.Pre = ""
+	releaseRequest := request
+	releaseRequest.Version = releaseVersion.String()
+	if strings.HasSuffix(request.Version, adoptium.EarlyAccessSuffix) {
+		releaseRequest.Version += adoptium.EarlyAccessSuffix
+	}
+
+	release, err := releaseProvider.GetRelease(ctx, releaseRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	details.ReleaseName = release.ReleaseName
+	details.ReleaseLink = release.ReleaseLink
+	if !release.Timestamp.IsZero() {
+		details.ReleaseDate = release.Timestamp.Format("2006-01-02")
+	}
+	if release.ReleaseNotes != nil {
+		details.ReleaseNotes = release.ReleaseNotes.Link
+	}
+
+	seen := make(map[string]bool)
+	for _, binary := range release.Binaries {
+		name := binary.Platform().String()
+		if binary.ImageType != request.ImageTypeOrDefault() || seen[name] {
+			continue
+		}
+		seen[name] = true
+		details.Platforms = append(details.Platforms, name)
+	}
+
+	return details, nil
+}
+
+// installedDetails describes an installed version from its manifest
+func installedDetails(cfg *config.Config, manager *jdk.Manager, installName string) (*releaseDetails, error) {
+	installation, err := manager.GetInstallation(installName)
+	if err != nil {
+		return nil, err
+	}
+	if installation == nil {
+		return nil, fmt.Errorf("JDK %s is not installed", installName)
+	}
+
+	diskSize, err := dirSize(installation.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := installation.Metadata
+	details := &releaseDetails{
+		Vendor:      installation.Vendor(),
+		Version:     installName,
+		Build:       metadata.Build,
+		ImageType:   metadata.ImageType,
+		Platform:    metadata.Platform.String(),
+		Size:        metadata.Size,
+		Checksum:    metadata.Checksum,
+		DownloadURL: metadata.URL,
+		Installed:   true,
+		InstallPath: installation.Path,
+		DiskSize:    diskSize,
+	}
+	if metadata.Version != "" {
+		details.Version = metadata.Version
+	}
+	if metadata.URL != "" {
+		details.Filename = path.Base(metadata.URL)
+	}
+	if !metadata.InstalledAt.IsZero() {
+		details.InstalledAt = metadata.InstalledAt.Local().Format("2006-01-02 15:04")
+	}
+	// The release file identifies the build, also for JDKs not installed by jdk-manager
+	if release := installation.Release; release != nil {
+		details.Implementor = release.Implementor
+		details.RuntimeVersion = release.RuntimeVersion
+		details.Modules = release.Modules
+		if details.RuntimeVersion == "" {
+			details.RuntimeVersion = release.JavaVersion
+		}
+		if metadata.Version == "" {
+			if v, err := release.Version(); err == nil {
+				details.Version = v.String()
+				details.Build = v.Build
+			}
+		}
+	}
+	if vendor, err := getProvider(cfg, details.Vendor); err == nil {
+		details.Vendor = vendor.Vendor().DisplayName
+	}
+
+	return details, nil
+}
+
+// printDetails prints release details as an aligned list, leaving out unknown values
+func printDetails(details *releaseDetails) {
+	title := details.Version
+	if details.Vendor != "" {
+		title = details.Vendor + " " + title
+	}
+	fmt.Printf("%s (%s)\n", title, details.ImageType)
+
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Printf("  %-15s %s\n", name+":", value)
+		}
+	}
+
+	field("Release", details.ReleaseName)
+	field("Implementor", details.Implementor)
+	field("Runtime", details.RuntimeVersion)
+	if details.Build > 0 {
+		field("Build", fmt.Sprintf("%d", details.Build))
+	}
+	field("Release date", details.ReleaseDate)
+	field("Platform", details.Platform)
+	if details.Filename != "" {
+		archive := details.Filename
+		if details.Size > 0 {
+			archive += fmt.Sprintf(" (%s)", formatSize(details.Size))
+		}
+		field("Archive", archive)
+	}
+	field("SHA-256", details.Checksum)
+	field("Download", details.DownloadURL)
+	field("Release notes", details.ReleaseNotes)
+	field("Release page", details.ReleaseLink)
+	field("Platforms", strings.Join(details.Platforms, ", "))
+	if len(details.Modules) > 0 {
+		lines := wrapWords(details.Modules, 64)
+		field(fmt.Sprintf("Modules (%d)", len(details.Modules)), lines[0])
+		for _, line := range lines[1:] {
+			fmt.Printf("  %-15s %s\n", "", line)
+		}
+	}
+	if details.Installed {
+		field("Installed at", details.InstallPath)
+	}
+	field("Install date", details.InstalledAt)
+	if details.DiskSize > 0 {
+		field("Disk usage", formatSize(details.DiskSize))
+	}
+}
+
+// writeJSON writes release details as indented JSON
+func writeJSON(w io.Writer, details *releaseDetails) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(details)
+}
+
+// formatSize renders a byte count with a binary unit, e.g. 190.4 MiB
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	value := float64(size)
+	units := []string{"KiB", "MiB", "GiB", "TiB"}
+	i := -1
+	for value >= unit && i < len(units)-1 {
+		value /= unit
+		i++
+	}
+	return fmt.Sprintf("%.1f %s", value, units[i])
+}
+
+// dirSize returns the total size of the regular files below a directory
+func dirSize(root string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Type().IsRegular() {
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/config"
+	"github.com/jdk-manager/internal/jdk"
+)
+
+func TestFormatSize(t *testing.T) {
+	tests := []struct {
+		size     int64
+		expected string
+	}{
+		{512, "512 B"},
+		{1536, "1.5 KiB"},
+		{199_650_000, "190.4 MiB"},
+		{3 << 30, "3.0 GiB"},
+	}
+
+	for _, test := range tests {
+		if result := formatSize(test.size); result != test.expected {
+			t.Errorf("formatSize(%d) = %q, expected %q", test.size, result, test.expected)
+		}
+	}
+}
+
+func TestRemoteDetails(t *testing.T) {
+	// 21.0.2 has no Linux build yet, so downloads resolve to 21.0.1
+	releases := `[
+		{
+			"release_name": "jdk-21.0.2+13",
+			"version_data": {"major": 21, "minor": 0, "security": 2, "build": 13},
+			"binaries": [
+				{"os": "windows", "architecture": "x64", "image_type": "jdk", "package": {"name": "OpenJDK21U-jdk_x64_windows_hotspot_21.0.2_13.zip"}}
+			]
+		},
+		{
+			"release_name": "jdk-21.0.1+12",
+			"timestamp": "2023-10-20T10:00:00Z",
+			"version_data": {"major": 21, "minor": 0, "security": 1, "build": 12},
+			"binaries": [
+				{"os": "linux", "architecture": "x64", "image_type": "jdk", "c_lib": "glibc",
+				 "package": {"name": "OpenJDK21U-jdk_x64_linux_hotspot_21.0.1_12.tar.gz", "size": 1024, "checksum": "abc123", "link": "https://example.com/linux.tar.gz"}},
+				{"os": "windows", "architecture": "x64", "image_type": "jdk", "package": {"name": "OpenJDK21U-jdk_x64_windows_hotspot_21.0.1_12.zip"}}
+			]
+		}
+	]`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/assets/feature_releases/21/ga" || r.URL.Query().Get("page") != "0" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(releases))
+	}))
+	defer server.Close()
+
+	client := adoptium.NewClientWithOptions(adoptium.Options{BaseURL: server.URL})
+	details, err := remoteDetails(context.Background(), client, adoptium.DownloadRequest{
+		Version: "21",
+		OS:      "linux",
+		Arch:    "x64",
+		LibC:    adoptium.LibCGlibc,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get release details: %v", err)
+	}
+
+	if details.Version != "21.0.1+12" || details.Filename != "OpenJDK21U-jdk_x64_linux_hotspot_21.0.1_12.tar.gz" {
+		t.Errorf("Expected the 21.0.1 Linux download, got %+v", details)
+	}
+	if details.ReleaseName != "jdk-21.0.1+12" || details.ReleaseDate != "2023-10-20" {
+		t.Errorf("Expected the release details of the downloaded version, got %+v", details)
+	}
+	if strings.Join(details.Platforms, ",") != "linux-x64,windows-x64" {
+		t.Errorf("Unexpected platforms %v", details.Platforms)
+	}
+}
+
+func TestInstalledDetails(t *testing.T) {
+	manager := testManager(t)
+	installPath := addInstall(t, manager, "21", "temurin", "21.0.2+13", adoptium.ImageTypeJDK)
+	release := "IMPLEMENTOR=\"Eclipse Adoptium\"\nJAVA_VERSION=\"21.0.2\"\nJAVA_RUNTIME_VERSION=\"21.0.2+13-LTS\"\nMODULES=\"java.base java.logging\"\n"
+	if err := os.WriteFile(filepath.Join(installPath, jdk.ReleaseFile), []byte(release), 0644); err != nil {
+		t.Fatalf("Failed to write release file: %v", err)
+	}
+
+	details, err := installedDetails(&config.Config{}, manager, "21")
+	if err != nil {
+		t.Fatalf("Failed to get install details: %v", err)
+	}
+	if details.Version != "21.0.2+13" || details.Vendor != "Eclipse Temurin" || !details.Installed || details.InstallPath != installPath {
+		t.Errorf("Unexpected install details %+v", details)
+	}
+	if details.Implementor != "Eclipse Adoptium" || details.RuntimeVersion != "21.0.2+13-LTS" || len(details.Modules) != 2 {
+		t.Errorf("Expected the release file details, got %+v", details)
+	}
+	if details.DiskSize == 0 || details.InstalledAt == "" {
+		t.Errorf("Expected disk usage and install date, got %+v", details)
+	}
+
+	if _, err := installedDetails(&config.Config{}, manager, "17"); err == nil {
+		t.Error("Expected error for a version that is not installed")
+	}
+
+	var buf bytes.Buffer
+	if err := writeJSON(&buf, details); err != nil {
+		t.Fatalf("Failed to write JSON: %v", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Invalid JSON output: %v\n%s", err, buf.String())
+	}
+	if decoded["version"] != "21.0.2+13" || decoded["installed"] != true || decoded["implementor"] != "Eclipse Adoptium" {
+		t.Errorf("Unexpected JSON output %s", buf.String())
+	}
+	if _, ok := decoded["release_notes"]; ok {
+		t.Errorf("Expected unknown values to be left out, got %s", buf.String())
+	}
+}
@@ -1,34 +1,58 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
-	"strconv"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/jdk-manager/internal/adoptium"
 	"github.com/jdk-manager/internal/jdk"
+	"github.com/jdk-manager/internal/query"
+	"github.com/jdk-manager/internal/signature"
+	"github.com/jdk-manager/internal/utils"
 	"github.com/spf13/cobra"
 )
 
 var installCmd = &cobra.Command{
 	Use:   "install <version>",
 	Short: "Install a JDK version",
-	Long: `Download and install a JDK version from Eclipse Adoptium.
+	Long: `Download and install a JDK version. Eclipse Temurin (Adoptium) is used
+unless another distribution is selected with --vendor.
 	
 Examples:
   jdk install 21        # Install JDK 21 (latest)
   jdk install 17.0.8    # Install specific version
-  jdk install 11        # Install JDK 11 (latest)`,
+  jdk install 11        # Install JDK 11 (latest)
+  jdk install 25-ea     # Install the latest JDK 25 early-access build
+  jdk install lts       # Install the newest LTS release
+  jdk install ">=17 <21"  # Install the newest release from 17 up to, not including, 21
+  jdk install ~17.0.8   # Install the newest 17.0 update from 17.0.8 on
+  jdk install 21 --image-type jre   # Install the JDK 21 runtime only as 21-jre
+  jdk install 21 --os windows --arch x64  # Install into ~/.jdks/platforms/windows-x64
+  jdk install 21 --vendor corretto  # Install Amazon Corretto 21 as corretto-21`,
 	Args: cobra.ExactArgs(1),
 	Run:  runInstall,
 }
 
 var (
-	forceInstall bool
+	forceInstall  bool
+	skipSignature bool
+	installVendor string
+	imageType     string
+	installTarget platformFlags
 )
 
 func init() {
 	installCmd.Flags().BoolVarP(&forceInstall, "force", "f", false, "Force reinstall even if version exists")
+	installCmd.Flags().BoolVar(&skipSignature, "skip-signature", false, "Do not verify the GPG signature of the downloaded archive")
+	installCmd.Flags().StringVar(&imageType, "image-type", adoptium.ImageTypeJDK,
+		fmt.Sprintf("Image type to install (%s)", strings.Join(adoptium.ImageTypes, ", ")))
+	addPlatformFlags(installCmd, &installTarget)
+	addLibCFlag(installCmd, &installTarget)
+	addVendorFlags(installCmd, &installVendor)
 	rootCmd.AddCommand(installCmd)
 }
 
@@ -36,55 +60,120 @@ func runInstall(cmd *cobra.Command, args []string) {
 	version := args[0]
 	
 	// Validate version format
-	if !isValidVersion(version) {
-		checkError(fmt.Errorf("invalid version format: %s", version))
+	versionQuery, err := query.Parse(version)
+	checkError(err)
+	if !isValidImageType(imageType) {
+		checkError(fmt.Errorf("invalid image type: %s (expected one of %s)", imageType, strings.Join(adoptium.ImageTypes, ", ")))
 	}
+	platform, err := installTarget.platform()
+	checkError(err)
+
+	cfg, err := loadConfig()
+	checkError(err)
 
-	manager, err := jdk.NewManager()
+	jdkProvider, err := getProvider(cfg, installVendor)
 	checkError(err)
 
+	// Queries such as 17.x or lts are resolved to the newest matching release
+	version, err = resolveRemote(cmd.Context(), cfg, jdkProvider, versionQuery)
+	checkError(err)
+	if version != versionQuery.String() {
+		fmt.Printf("Resolved %s to %s\n", versionQuery, version)
+	}
+
+	// Keys and the security log live in ~/.jdks, builds for other platforms
+	// are kept out of the way of the local ones
+	localManager, err := jdk.NewManager()
+	checkError(err)
+	manager, err := jdk.NewManagerForPlatform(platform)
+	checkError(err)
+	timeout, err := lockTimeout(cfg)
+	checkError(err)
+	manager.SetLockTimeout(timeout)
+
+	// Builds from different vendors are kept in separate directories
+	installName := jdk.InstallName(jdkProvider.Vendor().Name, version, imageType)
+
 	// Check if already installed
 	if !forceInstall {
-		installed, err := manager.IsInstalled(version)
+		installed, err := manager.IsInstalled(installName)
 		checkError(err)
 		
 		if installed {
-			fmt.Printf("JDK %s is already installed.\n", version)
-			fmt.Printf("Use --force to reinstall or 'jdk use %s' to switch to it.\n", version)
+			fmt.Printf("JDK %s is already installed.\n", installName)
+			fmt.Printf("Use --force to reinstall or 'jdk use %s' to switch to it.\n", installName)
 			return
 		}
 	}
 
-	fmt.Printf("Installing JDK %s...\n", version)
+	fmt.Printf("Installing %s %s (%s) for %s...\n", jdkProvider.Vendor().DisplayName, version, imageType, platform)
 
-	// Get download info from Adoptium
-	client := adoptium.NewClient()
-	downloadInfo, err := client.GetDownloadInfo(version)
+	// Get download info from the selected distribution
+	downloadInfo, err := jdkProvider.GetDownloadInfo(cmd.Context(), adoptium.DownloadRequest{
+		Version:   version,
+		ImageType: imageType,
+		OS:        platform.OS,
+		Arch:      platform.Arch,
+		LibC:      platform.LibC,
+	})
 	checkError(err)
 
 	if downloadInfo == nil {
 		checkError(fmt.Errorf("JDK version %s not found", version))
 	}
 
+	// Try configured mirrors before the upstream location
+	downloadInfo.Mirrors, err = utils.MirrorURLs(downloadInfo.URL, cfg.Mirrors)
+	checkError(err)
+
+	if skipSignature {
+		message := fmt.Sprintf("signature verification skipped (--skip-signature) for %s from %s", installName, downloadInfo.URL)
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", message)
+		checkError(appendSecurityLog(localManager, message))
+	} else if downloadInfo.SignatureURL != "" {
+		verifier, err := signature.LoadVerifier(cmd.Context(), filepath.Join(localManager.GetJDKsDir(), "keys"), cfg.SignatureKeyURL, cfg.SignatureKeys)
+		checkError(err)
+		manager.SetSignatureVerifier(verifier)
+	}
+
 	// Install the JDK
-	err = manager.Install(version, downloadInfo)
+	err = manager.Install(cmd.Context(), installName, downloadInfo, forceInstall)
+	if errors.Is(err, jdk.ErrAlreadyInstalled) {
+		// Installed by another jdk process while this one waited for it
+		fmt.Printf("JDK %s is already installed.\n", installName)
+		fmt.Printf("Use --force to reinstall or 'jdk use %s' to switch to it.\n", installName)
+		return
+	}
 	checkError(err)
 
-	fmt.Printf("✓ JDK %s installed successfully!\n", version)
-	fmt.Printf("Use 'jdk use %s' to switch to this version.\n", version)
+	fmt.Printf("✓ JDK %s installed successfully!\n", installName)
+	if platform.IsCurrent() && (imageType == adoptium.ImageTypeJDK || imageType == adoptium.ImageTypeJRE) {
+		fmt.Printf("Use 'jdk use %s' to switch to this version.\n", installName)
+	} else {
+		fmt.Printf("Installed to %s\n", filepath.Join(manager.GetJDKsDir(), installName))
+	}
 }
 
-// isValidVersion checks if the version string is in a valid format
-func isValidVersion(version string) bool {
-	// Allow formats like: 21, 17.0.8, 11.0.20
-	// Standard JDK versions follow major[.minor[.security]] format
-	parts := strings.Split(version, ".")
-	
-	for _, part := range parts {
-		if _, err := strconv.Atoi(part); err != nil {
-			return false
+// appendSecurityLog records security relevant decisions in ~/.jdks/security.log
+func appendSecurityLog(manager *jdk.Manager, message string) error {
+	logPath := filepath.Join(manager.GetJDKsDir(), "security.log")
+
+	f, err := os.OpenFile(logPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open security log: %w", err)
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "%s %s\n", time.Now().Format(time.RFC3339), message)
+	return err
+}
+
+// isValidImageType checks if the image type is one that can be requested
+func isValidImageType(imageType string) bool {
+	for _, supported := range adoptium.ImageTypes {
+		if imageType == supported {
+			return true
 		}
 	}
-	
-	return len(parts) >= 1 && len(parts) <= 3  // Allow up to 3 parts: major.minor.security
+	return false
 }
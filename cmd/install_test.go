@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"testing"
+
+	"github.com/jdk-manager/internal/query"
 )
 
-func TestIsValidVersion(t *testing.T) {
+func TestInstallVersionFormat(t *testing.T) {
 	tests := []struct {
 		version string
 		valid   bool
@@ -14,20 +16,50 @@ func TestIsValidVersion(t *testing.T) {
 		{"11.0.20", true},
 		{"8", true},
 		{"21.0", true},
-		{"17.0.8.1", false},  // Not a standard JDK version format (too many parts)
+		{"17.0.8.1", true},
+		{"11.0.20.1", true},
 		{"", false},
 		{"invalid", false},
 		{"21.invalid", false},
 		{"21.0.invalid", false},
 		{"v21", false},
-		{"21-ea", false},
+		{"21-ea", true},
+		{"21.0.2-ea", true},
+		{"-ea", false},
+		{"21-beta", false},
+		{"21.0.2+13", true},
+		{"jdk-21.0.2+13", true},
+		{"8u392", true},
+		{"1.8.0_392", true},
+		{"1.8.0_392-b08", true},
+		{"latest", true},
+		{"lts", true},
+		{"17.x", true},
+		{"~17.0.8", true},
+		{">=17 <21", true},
+		{">=17 <x", false},
+		{"17.y", false},
 	}
 
 	for _, test := range tests {
-		// Call the isValidVersion function from install.go
-		result := isValidVersion(test.version) 
-		if result != test.valid {
-			t.Errorf("isValidVersion(%s) = %v, expected %v", test.version, result, test.valid)
+		// install accepts whatever query.Parse accepts
+		_, err := query.Parse(test.version)
+		if result := err == nil; result != test.valid {
+			t.Errorf("query.Parse(%s) valid = %v, expected %v", test.version, result, test.valid)
+		}
+	}
+}
+
+func TestIsValidImageType(t *testing.T) {
+	for _, imageType := range []string{"jdk", "jre", "debugimage", "staticlibs", "sources"} {
+		if !isValidImageType(imageType) {
+			t.Errorf("isValidImageType(%s) = false, expected true", imageType)
+		}
+	}
+
+	for _, imageType := range []string{"", "JDK", "testimage"} {
+		if isValidImageType(imageType) {
+			t.Errorf("isValidImageType(%s) = true, expected false", imageType)
 		}
 	}
 }
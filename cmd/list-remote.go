@@ -1,45 +1,89 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"sort"
-	// "strconv"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/config"
+	"github.com/jdk-manager/internal/lts"
+	"github.com/jdk-manager/internal/provider"
 	"github.com/spf13/cobra"
 )
 
 var listRemoteCmd = &cobra.Command{
-	Use:   "list-remote",
-	Short: "List available JDK versions from Adoptium",
-	Long:  `Fetch and display available JDK versions from the Eclipse Adoptium API.`,
-	Run:   runListRemote,
+	Use:   "list-remote [major]",
+	Short: "List available JDK versions",
+	Long: `Fetch and display available JDK versions. Eclipse Temurin (Adoptium) is used
+unless another distribution is selected with --vendor.
+
+Without arguments the latest release of every major version is shown.
+Give a major version to see all of its installable patch levels.
+
+Examples:
+  jdk list-remote        # Latest release of each major version
+  jdk list-remote 17     # All JDK 17 releases with build numbers`,
+	Args: cobra.MaximumNArgs(1),
+	Run:  runListRemote,
 }
 
 var (
-	showAll bool
-	ltsOnly bool
+	showAll          bool
+	ltsOnly          bool
+	listRemoteVendor string
 )
 
 func init() {
 	listRemoteCmd.Flags().BoolVar(&showAll, "all", false, "Show all versions (including pre-release)")
 	listRemoteCmd.Flags().BoolVar(&ltsOnly, "lts", false, "Show only LTS versions")
+	addVendorFlags(listRemoteCmd, &listRemoteVendor)
 	rootCmd.AddCommand(listRemoteCmd)
 }
 
 func runListRemote(cmd *cobra.Command, args []string) {
-	fmt.Println("Fetching available JDK versions from Adoptium...")
+	majorFilter := 0
+	if len(args) == 1 {
+		major, err := strconv.Atoi(args[0])
+		if err != nil || major <= 0 {
+			checkError(fmt.Errorf("invalid major version: %s", args[0]))
+		}
+		majorFilter = major
+	}
+
+	cfg, err := loadConfig()
+	checkError(err)
 
-	client := adoptium.NewClient()
-	releases, err := client.GetAvailableReleases()
+	jdkProvider, err := getProvider(cfg, listRemoteVendor)
 	checkError(err)
 
+	fmt.Printf("Fetching available JDK versions from %s...\n", jdkProvider.Vendor().DisplayName)
+
+	releases, err := getReleases(cmd.Context(), jdkProvider, majorFilter)
+	checkError(err)
+
+	// Early-access builds are only fetched when they will be shown
+	if showAll {
+		if preReleaseProvider, ok := jdkProvider.(provider.PreReleaseProvider); ok {
+			preReleases, err := preReleaseProvider.GetPreReleases(cmd.Context())
+			checkError(err)
+			releases = append(releases, preReleases...)
+		} else {
+			fmt.Printf("%s does not publish early-access builds.\n", jdkProvider.Vendor().DisplayName)
+		}
+	}
+
 	if len(releases) == 0 {
 		fmt.Println("No JDK versions available.")
 		return
 	}
 
+	ltsReleases := getLTSReleases(cmd.Context(), cfg, jdkProvider)
+
 	// Filter releases based on flags
 	var filteredReleases []adoptium.Release
 	for _, release := range releases {
@@ -49,7 +93,12 @@ func runListRemote(cmd *cobra.Command, args []string) {
 		}
 
 		// Show only LTS versions if --lts is specified
-		if ltsOnly && !isLTSVersion(release.VersionData.Major) {
+		if ltsOnly && !lts.IsLTS(ltsReleases, release.VersionData.Major) {
+			continue
+		}
+
+		// Show only the requested major version
+		if majorFilter > 0 && release.VersionData.Major != majorFilter {
 			continue
 		}
 
@@ -57,22 +106,26 @@ func runListRemote(cmd *cobra.Command, args []string) {
 	}
 
 	// Sort by version (descending)
-	sort.Slice(filteredReleases, func(i, j int) bool {
-		return filteredReleases[i].VersionData.Major > filteredReleases[j].VersionData.Major
+	sort.SliceStable(filteredReleases, func(i, j int) bool {
+		return filteredReleases[i].VersionData.Compare(filteredReleases[j].VersionData) > 0
 	})
 
+	// Without a major version only the newest release of each major is shown
+	if majorFilter == 0 {
+		filteredReleases = latestPerMajor(filteredReleases)
+	}
+
+	if majorFilter > 0 && len(filteredReleases) == 0 {
+		fmt.Printf("\nNo JDK %d releases available.\n", majorFilter)
+		return
+	}
+
 	fmt.Printf("\nAvailable JDK versions:\n")
 	for _, release := range filteredReleases {
-		versionStr := fmt.Sprintf("%d", release.VersionData.Major)
-		if release.VersionData.Minor > 0 {
-			versionStr += fmt.Sprintf(".%d", release.VersionData.Minor)
-		}
-		if release.VersionData.Security > 0 {
-			versionStr += fmt.Sprintf(".%d", release.VersionData.Security)
-		}
+		versionStr := formatVersionData(release.VersionData)
 
 		markers := []string{}
-		if isLTSVersion(release.VersionData.Major) {
+		if lts.IsLTS(ltsReleases, release.VersionData.Major) {
 			markers = append(markers, "LTS")
 		}
 		if release.PreRelease {
@@ -87,7 +140,17 @@ func runListRemote(cmd *cobra.Command, args []string) {
 		fmt.Printf("  %s%s\n", versionStr, markerStr)
 	}
 
+	if cachedSince := metadataCache.CachedSince(); !cachedSince.IsZero() {
+		fmt.Printf("\nRelease data cached %s. Use --refresh to update it.\n", formatAge(time.Since(cachedSince)))
+	}
+
 	fmt.Printf("\nUse 'jdk install <version>' to install a specific version.\n")
+	if showAll {
+		fmt.Printf("Use 'jdk install <major>-ea' to install the latest early-access build.\n")
+	}
+	if majorFilter == 0 {
+		fmt.Printf("Use 'jdk list-remote <major>' to see all releases of a major version.\n")
+	}
 	if !showAll {
 		fmt.Printf("Use 'jdk list-remote --all' to see all versions including pre-releases.\n")
 	}
@@ -96,18 +159,69 @@ func runListRemote(cmd *cobra.Command, args []string) {
 	}
 }
 
-// isLTSVersion returns true if the given major version is an LTS version
-func isLTSVersion(major int) bool {
-	ltsVersions := []int{8, 11, 17, 21} // Known LTS versions
-	for _, lts := range ltsVersions {
-		if major == lts {
-			return true
+// latestPerMajor keeps the first GA and the first pre-release of each major version
+// from a list sorted newest first
+func latestPerMajor(releases []adoptium.Release) []adoptium.Release {
+	type key struct {
+		major      int
+		preRelease bool
+	}
+
+	var latest []adoptium.Release
+	seen := make(map[key]bool)
+
+	for _, release := range releases {
+		k := key{release.VersionData.Major, release.PreRelease}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		latest = append(latest, release)
+	}
+
+	return latest
+}
+
+// formatVersionData renders a version as major[.minor.security[.patch]][-ea][+build]
+func formatVersionData(v adoptium.VersionData) string {
+	// Pre-releases are shown the way they are installed, e.g. 25-ea
+	if v.Pre != "" {
+		v.Pre = strings.TrimPrefix(adoptium.EarlyAccessSuffix, "-")
+	}
+	return v.Version().String()
+}
+
+// getReleases lists the GA releases of a distribution. With a major version other than 0,
+// providers that can list a single major version only fetch the releases of that one.
+func getReleases(ctx context.Context, jdkProvider provider.Provider, major int) ([]adoptium.Release, error) {
+	if majorProvider, ok := jdkProvider.(provider.MajorReleaseProvider); ok && major > 0 {
+		return majorProvider.GetMajorReleases(ctx, major)
+	}
+	return jdkProvider.GetAvailableReleases(ctx)
+}
+
+// getLTSReleases returns the LTS major versions reported by the provider. Like all
+// release metadata they are cached according to cache_ttl and --refresh/--offline.
+// Providers whose API has no LTS information use the Adoptium list, since LTS releases
+// are defined by OpenJDK rather than by the distribution.
+func getLTSReleases(ctx context.Context, cfg *config.Config, jdkProvider provider.Provider) []int {
+	source, ok := jdkProvider.(lts.Source)
+	if !ok {
+		defaultProvider, err := getProvider(cfg, "")
+		checkError(err)
+		source, ok = defaultProvider.(lts.Source)
+		if !ok {
+			return lts.KnownReleases
 		}
 	}
-	// Future LTS versions follow a pattern: every 3 years starting from 21
-	// So: 21, 24, 27, 30, etc. (21 + 3*n where n >= 1)
-	if major > 21 && (major-21)%3 == 0 {
-		return true
+
+	ltsReleases, err := lts.Releases(ctx, source)
+	if ctx.Err() != nil {
+		checkError(ctx.Err())
 	}
-	return false
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	return ltsReleases
 }
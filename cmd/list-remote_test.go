@@ -2,40 +2,44 @@ package cmd
 
 import (
 	"testing"
+
+	"github.com/jdk-manager/internal/adoptium"
 )
 
-func TestIsLTSVersion(t *testing.T) {
+func TestFormatVersionData(t *testing.T) {
 	tests := []struct {
-		version int
-		isLTS   bool
+		version  adoptium.VersionData
+		expected string
 	}{
-		{8, true},
-		{11, true},
-		{17, true},
-		{21, true},
-		{24, true},  // Future LTS (21 + 3*1)
-		{27, true},  // Future LTS (21 + 3*2)
-		{30, true},  // Future LTS (21 + 3*3)
-		{9, false},
-		{10, false},
-		{12, false},
-		{13, false},
-		{14, false},
-		{15, false},
-		{16, false},
-		{18, false},
-		{19, false},
-		{20, false},
-		{22, false},
-		{23, false},  // Not LTS (21 + 2, not divisible by 3)
-		{25, false},  // Not LTS (21 + 4, not divisible by 3)
-		{26, false},  // Not LTS (21 + 5, not divisible by 3)
+		{adoptium.VersionData{Major: 21}, "21"},
+		{adoptium.VersionData{Major: 17, Security: 10, Build: 7}, "17.0.10+7"},
+		{adoptium.VersionData{Major: 8, Security: 402, Build: 6}, "8.0.402+6"},
+		{adoptium.VersionData{Major: 11, Security: 20, Patch: 1, Build: 1}, "11.0.20.1+1"},
+		{adoptium.VersionData{Major: 25, Pre: "beta", Build: 30}, "25-ea+30"},
 	}
 
 	for _, test := range tests {
-		result := isLTSVersion(test.version)
-		if result != test.isLTS {
-			t.Errorf("isLTSVersion(%d) = %v, expected %v", test.version, result, test.isLTS)
+		result := formatVersionData(test.version)
+		if result != test.expected {
+			t.Errorf("formatVersionData(%+v) = %s, expected %s", test.version, result, test.expected)
 		}
 	}
 }
+
+func TestLatestPerMajor(t *testing.T) {
+	releases := []adoptium.Release{
+		{VersionData: adoptium.VersionData{Major: 21, Security: 2}},
+		{VersionData: adoptium.VersionData{Major: 21, Security: 2, Pre: "ea", Build: 5}, PreRelease: true},
+		{VersionData: adoptium.VersionData{Major: 21, Security: 1}},
+		{VersionData: adoptium.VersionData{Major: 17, Security: 10}},
+		{VersionData: adoptium.VersionData{Major: 17, Security: 9}},
+	}
+
+	latest := latestPerMajor(releases)
+	if len(latest) != 3 {
+		t.Fatalf("Expected 3 releases, got %d", len(latest))
+	}
+	if latest[0].VersionData.Security != 2 || !latest[1].PreRelease || latest[2].VersionData.Security != 10 {
+		t.Errorf("Expected newest GA and pre-release of each major, got %+v", latest)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"sort"
 	"strings"
 
 	"github.com/jdk-manager/internal/jdk"
@@ -14,40 +13,59 @@ import (
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List installed JDK versions",
-	Long:  `List all JDK versions currently installed in ~/.jdks directory.`,
-	Run:   runList,
+	Long: `List all JDK versions currently installed in ~/.jdks directory.
+With --long the vendor, full runtime version and modules recorded in each
+install's release file are shown as well. Use --os, --arch and --libc to list
+the builds installed for another platform.`,
+	Run: runList,
 }
 
+var (
+	listLong   bool
+	listTarget platformFlags
+)
+
 func init() {
+	listCmd.Flags().BoolVarP(&listLong, "long", "l", false, "Show vendor, runtime version and modules from the release file")
+	addPlatformFlags(listCmd, &listTarget)
+	addLibCFlag(listCmd, &listTarget)
 	rootCmd.AddCommand(listCmd)
 }
 
 func runList(cmd *cobra.Command, args []string) {
-	manager, err := jdk.NewManager()
+	platform, err := listTarget.platform()
 	checkError(err)
 
-	versions, err := manager.ListInstalled()
+	manager, err := jdk.NewManagerForPlatform(platform)
 	checkError(err)
 
-	if len(versions) == 0 {
-		fmt.Println("No JDK versions installed.")
-		fmt.Printf("Install a JDK version with: %s install <version>\n", os.Args[0])
-		return
+	installations, err := manager.Installations()
+	checkError(err)
+
+	forPlatform := ""
+	if !platform.IsCurrent() {
+		forPlatform = " for " + platform.String()
 	}
 
-	// Sort versions
-	sort.Strings(versions)
+	if len(installations) == 0 {
+		fmt.Printf("No JDK versions installed%s.\n", forPlatform)
+		fmt.Printf("Install a JDK version with: %s install <version>%s\n", os.Args[0], platformArgs(platform))
+		return
+	}
 
 	// Get current version
 	currentVersion := getCurrentVersion(manager)
 
-	fmt.Println("Installed JDK versions:")
-	for _, version := range versions {
+	fmt.Printf("Installed JDK versions%s:\n", forPlatform)
+	for _, installation := range installations {
 		marker := "  "
-		if version == currentVersion {
+		if installation.Name == currentVersion {
 			marker = "* " // Mark current version
 		}
-		fmt.Printf("%s%s\n", marker, version)
+		fmt.Printf("%s%s (%s)\n", marker, installation.Name, installationDetails(&installation))
+		if listLong {
+			printRelease(&installation, "    ")
+		}
 	}
 
 	if currentVersion != "" {
@@ -55,6 +73,71 @@ func runList(cmd *cobra.Command, args []string) {
 	}
 }
 
+// installationDetails summarizes the manifest of an installation, e.g.
+// "jdk, temurin 21.0.2+13, musl". Older installations only record the image type and C library.
+func installationDetails(installation *jdk.Installation) string {
+	metadata := installation.Metadata
+	details := []string{metadata.ImageType}
+	if metadata.Version != "" {
+		details = append(details, installation.Vendor()+" "+metadata.Version)
+	}
+	if metadata.LibC != "" {
+		details = append(details, metadata.LibC)
+	}
+	return strings.Join(details, ", ")
+}
+
+// printRelease prints the vendor, runtime version and modules from the release file
+// of an installation
+func printRelease(installation *jdk.Installation, indent string) {
+	release := installation.Release
+	if installation.ReleaseError != nil {
+		fmt.Printf("%s%-15s %v\n", indent, "Release file:", installation.ReleaseError)
+		return
+	}
+	if release == nil {
+		fmt.Printf("%s%-15s %s\n", indent, "Release file:", "none")
+		return
+	}
+
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Printf("%s%-15s %s\n", indent, name+":", value)
+		}
+	}
+	field("Implementor", release.Implementor)
+	runtimeVersion := release.RuntimeVersion
+	if runtimeVersion == "" {
+		runtimeVersion = release.JavaVersion
+	}
+	field("Runtime", runtimeVersion)
+	if len(release.Modules) > 0 {
+		lines := wrapWords(release.Modules, 64)
+		field(fmt.Sprintf("Modules (%d)", len(release.Modules)), lines[0])
+		for _, line := range lines[1:] {
+			fmt.Printf("%s%-15s %s\n", indent, "", line)
+		}
+	}
+}
+
+// wrapWords joins words with spaces into lines of at most width characters,
+// unless a single word is longer
+func wrapWords(words []string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range words {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	return append(lines, line)
+}
+
 // getCurrentVersion attempts to determine the currently active JDK version
 func getCurrentVersion(manager *jdk.Manager) string {
 	javaHome := os.Getenv("JAVA_HOME")
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWrapWords(t *testing.T) {
+	words := []string{"java.base", "java.compiler", "java.datatransfer", "jdk.incubator.vector"}
+
+	tests := []struct {
+		width    int
+		expected []string
+	}{
+		{80, []string{"java.base java.compiler java.datatransfer jdk.incubator.vector"}},
+		{41, []string{"java.base java.compiler java.datatransfer", "jdk.incubator.vector"}},
+		{10, []string{"java.base", "java.compiler", "java.datatransfer", "jdk.incubator.vector"}},
+	}
+
+	for _, test := range tests {
+		if result := wrapWords(words, test.width); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("wrapWords(%d) = %q, expected %q", test.width, result, test.expected)
+		}
+	}
+}
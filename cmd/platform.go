@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/jdk-manager/internal/adoptium"
+	"github.com/spf13/cobra"
+)
+
+// platformFlags holds the target platform options of a command
+type platformFlags struct {
+	os   string
+	arch string
+	libc string
+}
+
+// addPlatformFlags registers --os and --arch on a command
+func addPlatformFlags(cmd *cobra.Command, flags *platformFlags) {
+	cmd.Flags().StringVar(&flags.os, "os", "", "Target operating system, e.g. linux, mac, windows (default: current)")
+	cmd.Flags().StringVar(&flags.arch, "arch", "", "Target architecture, e.g. x64, aarch64 (default: current)")
+}
+
+// addLibCFlag registers --libc on a command
+func addLibCFlag(cmd *cobra.Command, flags *platformFlags) {
+	cmd.Flags().StringVar(&flags.libc, "libc", "", "C library of Linux builds: glibc or musl (default: detected)")
+}
+
+// platform returns the target platform selected by the flags, defaulting to the current system
+func (f *platformFlags) platform() (adoptium.Platform, error) {
+	request := adoptium.DownloadRequest{LibC: f.libc}
+
+	if f.os != "" {
+		osName, err := adoptium.ParseOS(f.os)
+		if err != nil {
+			return adoptium.Platform{}, err
+		}
+		request.OS = osName
+	}
+
+	if f.arch != "" {
+		arch, err := adoptium.ParseArch(f.arch)
+		if err != nil {
+			return adoptium.Platform{}, err
+		}
+		request.Arch = arch
+	}
+
+	if f.libc != "" && f.libc != adoptium.LibCGlibc && f.libc != adoptium.LibCMusl {
+		return adoptium.Platform{}, fmt.Errorf("invalid libc: %s (expected glibc or musl)", f.libc)
+	}
+
+	return request.Platform(), nil
+}
+
+// platformArgs returns the flags selecting a platform on the command line, e.g.
+// " --os linux --arch x64 --libc musl", or nothing for the current platform
+func platformArgs(platform adoptium.Platform) string {
+	if platform.IsCurrent() {
+		return ""
+	}
+	args := fmt.Sprintf(" --os %s --arch %s", platform.OS, platform.Arch)
+	if platform.LibC != "" {
+		args += " --libc " + platform.LibC
+	}
+	return args
+}
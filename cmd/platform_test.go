@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/jdk-manager/internal/adoptium"
+)
+
+func TestPlatformArgs(t *testing.T) {
+	if args := platformArgs(adoptium.CurrentPlatform()); args != "" {
+		t.Errorf("Expected no flags for the current platform, got %q", args)
+	}
+
+	windows := adoptium.Platform{OS: "windows", Arch: "x64"}
+	if args := platformArgs(windows); args != " --os windows --arch x64" {
+		t.Errorf("Unexpected flags %q for %s", args, windows)
+	}
+
+	musl := adoptium.Platform{OS: "linux", Arch: "s390x", LibC: adoptium.LibCMusl}
+	if args := platformArgs(musl); args != " --os linux --arch s390x --libc musl" {
+		t.Errorf("Unexpected flags %q for %s", args, musl)
+	}
+}
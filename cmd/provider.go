@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/config"
+	"github.com/jdk-manager/internal/corretto"
+	"github.com/jdk-manager/internal/foojay"
+	"github.com/jdk-manager/internal/graalvm"
+	"github.com/jdk-manager/internal/provider"
+	"github.com/jdk-manager/internal/zulu"
+	"github.com/spf13/cobra"
+)
+
+// newRegistry creates the registry of supported JDK distributions.
+// The first provider is used when no vendor is given.
+func newRegistry(cfg *config.Config) *provider.Registry {
+	registry := provider.NewRegistry(
+		adoptium.NewClientWithOptions(adoptium.Options{
+			BaseURL:      cfg.AdoptiumAPI,
+			DownloadHost: cfg.DownloadHost,
+		}),
+		corretto.NewClient(),
+		zulu.NewClient(),
+		graalvm.NewClient(),
+	)
+
+	// Vendors without a dedicated client are served through the foojay Disco API
+	for _, distribution := range foojay.Distributions {
+		checkError(registry.Register(foojay.NewClient(distribution)))
+	}
+
+	// All API requests go through the metadata cache
+	httpClient, err := apiHTTPClient(cfg)
+	checkError(err)
+	for _, p := range registry.Providers() {
+		if setter, ok := p.(provider.HTTPClientSetter); ok {
+			setter.SetHTTPClient(httpClient)
+		}
+	}
+
+	return registry
+}
+
+// addVendorFlags registers --vendor and its --distribution alias on a command
+func addVendorFlags(cmd *cobra.Command, vendor *string) {
+	cmd.Flags().StringVar(vendor, "vendor", "", "JDK distribution to use (default: temurin)")
+	cmd.Flags().StringVar(vendor, "distribution", "", "Alias for --vendor")
+}
+
+// getProvider returns the provider for the requested vendor
+func getProvider(cfg *config.Config, vendor string) (provider.Provider, error) {
+	return newRegistry(cfg).Get(vendor)
+}
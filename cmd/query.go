@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/config"
+	"github.com/jdk-manager/internal/jdk"
+	"github.com/jdk-manager/internal/provider"
+	"github.com/jdk-manager/internal/query"
+	jdkversion "github.com/jdk-manager/internal/version"
+)
+
+// errNotInstalled is returned when no install matches a version query
+var errNotInstalled = errors.New("not installed")
+
+// resolveRemote resolves a version query to the newest matching release of a distribution.
+// Plain versions such as 21 or 17.0.8 are returned unchanged for the distribution to resolve.
+func resolveRemote(ctx context.Context, cfg *config.Config, jdkProvider provider.Provider, q *query.Query) (string, error) {
+	if q.IsExact() {
+		return q.String(), nil
+	}
+
+	// Queries within one major version, such as 17.x, only need its releases
+	releases, err := getReleases(ctx, jdkProvider, q.Major())
+	if err != nil {
+		return "", err
+	}
+
+	var ltsReleases []int
+	if q.NeedsLTS() {
+		ltsReleases = getLTSReleases(ctx, cfg, jdkProvider)
+	}
+
+	candidates := make([]jdkversion.Version, len(releases))
+	for i, release := range releases {
+		candidates[i] = release.VersionData.Version()
+	}
+
+	best := q.Select(candidates, ltsReleases)
+	if best < 0 {
+		return "", fmt.Errorf("no %s release matches %s", jdkProvider.Vendor().DisplayName, q)
+	}
+	return candidates[best].Number(), nil
+}
+
+// resolveInstalled resolves a version query to the newest matching installed version.
+// Names of installed versions, e.g. corretto-21 or 21-jre, are returned unchanged.
+// Exact versions such as 21.0.2+13 only match full JDKs of the default vendor, the
+// installs named by the plain version; ranges and keywords match any install.
+// With runtimeOnly only installs that contain a Java runtime are considered.
+func resolveInstalled(ctx context.Context, manager *jdk.Manager, version string, runtimeOnly bool) (string, error) {
+	installed, err := manager.IsInstalled(version)
+	if err != nil || installed {
+		return version, err
+	}
+
+	q, err := query.Parse(version)
+	if err != nil {
+		return "", err
+	}
+
+	installations, err := manager.Installations()
+	if err != nil {
+		return "", err
+	}
+
+	var ltsReleases []int
+	if q.NeedsLTS() {
+		cfg, err := loadConfig()
+		if err != nil {
+			return "", err
+		}
+		defaultProvider, err := getProvider(cfg, "")
+		if err != nil {
+			return "", err
+		}
+		ltsReleases = getLTSReleases(ctx, cfg, defaultProvider)
+	}
+
+	// Installs are matched by the exact version recorded in their manifest
+	var candidateNames []string
+	var candidates []jdkversion.Version
+	for _, installation := range installations {
+		if runtimeOnly && !installation.Metadata.IsRuntime() {
+			continue
+		}
+		if q.IsExact() && (installation.Vendor() != jdk.DefaultVendor || installation.Metadata.ImageType != adoptium.ImageTypeJDK) {
+			continue
+		}
+
+		v, err := installation.Version()
+		if err != nil {
+			continue
+		}
+		candidateNames = append(candidateNames, installation.Name)
+		candidates = append(candidates, v)
+	}
+
+	best := q.Select(candidates, ltsReleases)
+	if best < 0 {
+		return "", fmt.Errorf("JDK %s is %w", q, errNotInstalled)
+	}
+	return candidateNames[best], nil
+}
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/jdk-manager/internal/jdk"
+	"github.com/mitchellh/go-homedir"
+)
+
+// testManager returns a manager for a JDK store in a temporary home directory
+func testManager(t *testing.T) *jdk.Manager {
+	t.Helper()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	homedir.DisableCache = true
+	t.Cleanup(func() { homedir.DisableCache = false; homedir.Reset() })
+
+	manager, err := jdk.NewManager()
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	return manager
+}
+
+// addInstall creates a minimal install with a manifest in the manager's store
+func addInstall(t *testing.T, manager *jdk.Manager, name, vendor, version, imageType string) string {
+	t.Helper()
+
+	installPath := filepath.Join(manager.GetJDKsDir(), name)
+	if err := os.MkdirAll(filepath.Join(installPath, "bin"), 0755); err != nil {
+		t.Fatalf("Failed to create install: %v", err)
+	}
+	for _, executable := range []string{"java", "javac"} {
+		if runtime.GOOS == "windows" {
+			executable += ".exe"
+		}
+		if err := os.WriteFile(filepath.Join(installPath, "bin", executable), nil, 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", executable, err)
+		}
+	}
+
+	platform := manager.GetPlatform()
+	manifest := fmt.Sprintf(`{"vendor": %q, "version": %q, "image_type": %q, "os": %q, "arch": %q, "libc": %q, "installed_at": "2024-01-20T10:00:00Z"}`,
+		vendor, version, imageType, platform.OS, platform.Arch, platform.LibC)
+	if err := os.WriteFile(filepath.Join(installPath, jdk.MetadataFile), []byte(manifest), 0644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+	return installPath
+}
+
+func TestResolveInstalled(t *testing.T) {
+	manager := testManager(t)
+	addInstall(t, manager, "17.0.8", "temurin", "17.0.8+7", "jdk")
+	addInstall(t, manager, "corretto-21", "corretto", "21.0.3+9", "jdk")
+	addInstall(t, manager, "21-jre", "temurin", "21.0.2+13", "jre")
+
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"corretto-21", "corretto-21"},
+		{"17.0.8+7", "17.0.8"},
+		{"17", "17.0.8"},
+		{"21.x", "corretto-21"},
+		{">=17 <21", "17.0.8"},
+	}
+
+	for _, test := range tests {
+		resolved, err := resolveInstalled(context.Background(), manager, test.query, false)
+		if err != nil || resolved != test.expected {
+			t.Errorf("resolveInstalled(%q) = %q (%v), expected %q", test.query, resolved, err, test.expected)
+		}
+	}
+
+	// Plain versions never match installs of other vendors or image types
+	for _, query := range []string{"21", "21.0.2", "21.0.3"} {
+		if resolved, err := resolveInstalled(context.Background(), manager, query, false); !errors.Is(err, errNotInstalled) {
+			t.Errorf("resolveInstalled(%q) = %q (%v), expected not installed", query, resolved, err)
+		}
+	}
+
+	if _, err := resolveInstalled(context.Background(), manager, "v21", false); err == nil || errors.Is(err, errNotInstalled) {
+		t.Errorf("Expected a parse error for an invalid query, got %v", err)
+	}
+}
+
+func TestResolveUninstall(t *testing.T) {
+	manager := testManager(t)
+	addInstall(t, manager, "17.0.8", "temurin", "17.0.8+7", "jdk")
+
+	// A partially extracted install has neither a manifest nor bin/java
+	broken := filepath.Join(manager.GetJDKsDir(), "21")
+	if err := os.MkdirAll(filepath.Join(broken, "lib"), 0755); err != nil {
+		t.Fatalf("Failed to create broken install: %v", err)
+	}
+
+	resolved, err := resolveUninstall(context.Background(), manager, "21")
+	if err != nil || resolved != "21" {
+		t.Fatalf("Expected the broken install to be selected by name, got %q (%v)", resolved, err)
+	}
+	if err := manager.Uninstall(context.Background(), resolved); err != nil {
+		t.Fatalf("Failed to uninstall: %v", err)
+	}
+	if _, err := os.Stat(broken); !os.IsNotExist(err) {
+		t.Error("Expected the broken install to be removed")
+	}
+
+	if resolved, err := resolveUninstall(context.Background(), manager, "17"); err != nil || resolved != "17.0.8" {
+		t.Errorf("Expected queries to be resolved against the installs, got %q (%v)", resolved, err)
+	}
+
+	// The store's own directories are never uninstalled by name
+	if err := os.MkdirAll(filepath.Join(manager.GetJDKsDir(), "cache"), 0755); err != nil {
+		t.Fatalf("Failed to create cache directory: %v", err)
+	}
+	if _, err := resolveUninstall(context.Background(), manager, "cache"); err == nil {
+		t.Error("Expected the cache directory not to be uninstallable")
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	tests := map[string]bool{
+		"y\n":    true,
+		"YES\n":  true,
+		"yes":    true,
+		"n\n":    false,
+		"\n":     false,
+		"":       false,
+		"sure\n": false,
+	}
+
+	for answer, expected := range tests {
+		if result := confirm(strings.NewReader(answer), "Uninstall?"); result != expected {
+			t.Errorf("confirm(%q) = %v, expected %v", answer, result, expected)
+		}
+	}
+}
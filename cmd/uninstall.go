@@ -1,8 +1,13 @@
 package cmd
 
 import (
+	"bufio"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/jdk-manager/internal/jdk"
 	"github.com/spf13/cobra"
@@ -15,32 +20,90 @@ var uninstallCmd = &cobra.Command{
 	
 Examples:
   jdk uninstall 21        # Uninstall JDK 21
-  jdk uninstall 17.0.8    # Uninstall specific version`,
+  jdk uninstall 17.0.8    # Uninstall specific version
+  jdk uninstall "<17"     # Uninstall the newest installed version before 17
+
+Versions resolved from a query are only removed after confirmation, unless --yes is given.
+Use --os, --arch and --libc to remove a build installed for another platform.`,
 	Args: cobra.ExactArgs(1),
 	Run:  runUninstall,
 }
 
+var (
+	uninstallYes    bool
+	uninstallTarget platformFlags
+)
+
 func init() {
+	uninstallCmd.Flags().BoolVarP(&uninstallYes, "yes", "y", false, "Uninstall a version resolved from a query without asking")
+	addPlatformFlags(uninstallCmd, &uninstallTarget)
+	addLibCFlag(uninstallCmd, &uninstallTarget)
 	rootCmd.AddCommand(uninstallCmd)
 }
 
 func runUninstall(cmd *cobra.Command, args []string) {
 	version := args[0]
+	platform, err := uninstallTarget.platform()
+	checkError(err)
 
-	manager, err := jdk.NewManager()
+	cfg, err := loadConfig()
+	checkError(err)
+	timeout, err := lockTimeout(cfg)
 	checkError(err)
 
-	// Check if version is installed before attempting to uninstall
-	installed, err := manager.IsInstalled(version)
+	manager, err := jdk.NewManagerForPlatform(platform)
 	checkError(err)
+	manager.SetLockTimeout(timeout)
 
-	if !installed {
+	// Check if version is installed before attempting to uninstall,
+	// resolving queries such as 17.x to the newest matching install
+	resolved, err := resolveUninstall(cmd.Context(), manager, version)
+	if errors.Is(err, errNotInstalled) {
 		fmt.Fprintf(os.Stderr, "Error: JDK %s is not installed. Nothing to uninstall.\n", version)
 		os.Exit(1)
 	}
+	checkError(err)
+	if resolved != version {
+		fmt.Printf("Resolved %s to %s\n", version, resolved)
+
+		// Only the exact name of an install removes it without asking
+		if !uninstallYes && !confirm(os.Stdin, fmt.Sprintf("Uninstall JDK %s?", resolved)) {
+			fmt.Println("Nothing uninstalled.")
+			return
+		}
+		version = resolved
+	}
 
-	err = manager.Uninstall(version)
+	err = manager.Uninstall(cmd.Context(), version)
 	checkError(err)
 
 	fmt.Printf("✓ JDK %s uninstalled successfully!\n", version)
 }
+
+// resolveUninstall resolves the argument of uninstall to an install directory. A
+// directory named exactly is removed even if it does not hold a valid install, e.g.
+// after an interrupted extraction; anything else is resolved as a query.
+func resolveUninstall(ctx context.Context, manager *jdk.Manager, version string) (string, error) {
+	if manager.HasInstallDir(version) {
+		return version, nil
+	}
+	return resolveInstalled(ctx, manager, version, false)
+}
+
+// confirm asks a yes/no question and reads the answer from in. Anything but y or yes,
+// including a closed input, is a no.
+func confirm(in io.Reader, question string) bool {
+	fmt.Printf("%s [y/N] ", question)
+
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Println()
+		return false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	// "runtime" // No longer directly used here for OS-specific commands, manager handles it
@@ -18,7 +19,9 @@ These commands are typically executed by the 'jdk' shell function set up by the
 
 Examples:
   jdk use 21     # Switch to JDK 21
-  jdk use 17.0.8 # Switch to specific version`,
+  jdk use 17.0.8 # Switch to specific version
+  jdk use 17.x   # Switch to the newest installed JDK 17
+  jdk use lts    # Switch to the newest installed LTS release`,
 	Args: cobra.ExactArgs(1),
 	Run:  runUse,
 }
@@ -33,15 +36,26 @@ func runUse(cmd *cobra.Command, args []string) {
 	manager, err := jdk.NewManager()
 	checkError(err)
 
-	// Check if version is installed
-	installed, err := manager.IsInstalled(version)
-	checkError(err)
-
-	if !installed {
+	// Check if version is installed, resolving queries such as 17.x or lts
+	resolved, err := resolveInstalled(cmd.Context(), manager, version, true)
+	if errors.Is(err, errNotInstalled) {
 		fmt.Fprintf(os.Stderr, "Error: JDK %s is not installed.\n", version) // Print error to stderr
 		fmt.Fprintf(os.Stderr, "Install it with: jdk install %s\n", version)
 		os.Exit(1) // Exit with error code
 	}
+	checkError(err)
+	if resolved != version {
+		// stdout is evaluated by the shell function, so notes go to stderr
+		fmt.Fprintf(os.Stderr, "Resolved %s to %s\n", version, resolved)
+		version = resolved
+	}
+
+	// Debug images, static libraries and sources cannot be activated
+	metadata, err := manager.GetMetadata(version)
+	checkError(err)
+	if !metadata.IsRuntime() {
+		checkError(fmt.Errorf("%s is a %s install and contains no Java runtime", version, metadata.ImageType))
+	}
 
 	// Get the JDK path
 	jdkPath, err := manager.GetJDKPath(version)
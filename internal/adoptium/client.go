@@ -1,135 +1,491 @@
 package adoptium
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"runtime"
+	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/jdk-manager/internal/httpclient"
+	"github.com/jdk-manager/internal/version"
 )
 
 const (
 	adoptiumAPIBase = "https://api.adoptium.net/v3"
+
+	// Release types of the Adoptium API
+	releaseTypeGA = "ga"
+	releaseTypeEA = "ea"
+
+	// Page sizes allowed by the API and an upper bound on paging
+	releaseVersionsPageSize = 50
+	assetsPageSize          = 20
+	maxPages                = 50
 )
 
+// Image types that can be requested. Not every distribution publishes all of them.
+const (
+	ImageTypeJDK        = "jdk"
+	ImageTypeJRE        = "jre"
+	ImageTypeDebugImage = "debugimage"
+	ImageTypeStaticLibs = "staticlibs"
+	ImageTypeSources    = "sources"
+)
+
+// ImageTypes lists the supported image types, the default first
+var ImageTypes = []string{ImageTypeJDK, ImageTypeJRE, ImageTypeDebugImage, ImageTypeStaticLibs, ImageTypeSources}
+
+// EarlyAccessSuffix marks a requested version as an early-access build, e.g. 25-ea
+const EarlyAccessSuffix = "-ea"
+
 // Client handles communication with the Adoptium API
 type Client struct {
-	httpClient *http.Client
+	httpClient   *http.Client
+	baseURL      string
+	downloadHost string
+}
+
+// Options configures the endpoints used by the client
+type Options struct {
+	// BaseURL is the Adoptium API base URL. Defaults to https://api.adoptium.net/v3.
+	BaseURL string
+	// DownloadHost, if set, replaces the scheme and host of package download links
+	// (e.g. https://artifactory.example.com/github). Any path is used as a prefix.
+	DownloadHost string
 }
 
 // Release represents a JDK release from Adoptium
 type Release struct {
-	VersionData VersionData `json:"version_data"`
-	PreRelease  bool        `json:"prerelease"`
-	Binaries    []Binary    `json:"binaries"`
+	VersionData   VersionData   `json:"version_data"`
+	PreRelease    bool          `json:"prerelease"`
+	Binaries      []Binary      `json:"binaries"`
+	ReleaseName   string        `json:"release_name"` // e.g. jdk-21.0.2+13
+	ReleaseLink   string        `json:"release_link"` // Release page of the build
+	ReleaseType   string        `json:"release_type"` // ga or ea
+	Vendor        string        `json:"vendor"`       // e.g. eclipse
+	Timestamp     time.Time     `json:"timestamp"`    // Release date
+	UpdatedAt     time.Time     `json:"updated_at"`
+	DownloadCount int64         `json:"download_count"`
+	ReleaseNotes  *ReleaseNotes `json:"release_notes,omitempty"`
+}
+
+// ReleaseNotes points to the release notes of a release
+type ReleaseNotes struct {
+	Name string `json:"name"`
+	Link string `json:"link"`
 }
 
 // VersionData contains version information
 type VersionData struct {
-	Major    int `json:"major"`
-	Minor    int `json:"minor"`
-	Security int `json:"security"`
-	Build    int `json:"build"`
+	Major          int    `json:"major"`
+	Minor          int    `json:"minor"`
+	Security       int    `json:"security"`
+	Patch          int    `json:"patch"`
+	Build          int    `json:"build"`
+	Pre            string `json:"pre"`             // Pre-release identifier, e.g. "ea"
+	Semver         string `json:"semver"`          // e.g. 17.0.10+7
+	OpenJDKVersion string `json:"openjdk_version"` // e.g. 17.0.10+7
+}
+
+// Version converts the version data into a comparable version
+func (v VersionData) Version() version.Version {
+	return version.Version{
+		Major:    v.Major,
+		Minor:    v.Minor,
+		Security: v.Security,
+		Patch:    v.Patch,
+		Pre:      v.Pre,
+		Build:    v.Build,
+	}
+}
+
+// NewVersionData converts a parsed version into version data
+func NewVersionData(v version.Version) VersionData {
+	return VersionData{
+		Major:    v.Major,
+		Minor:    v.Minor,
+		Security: v.Security,
+		Patch:    v.Patch,
+		Pre:      v.Pre,
+		Build:    v.Build,
+	}
+}
+
+// Compare returns -1, 0 or 1 depending on whether v is older, equal to or newer than other
+func (v VersionData) Compare(other VersionData) int {
+	return v.Version().Compare(other.Version())
+}
+
+// Matches reports whether v is a release of a requested version such as 17, 17.0.8,
+// 17.0.8+7, 8u392 or 1.8.0_392
+func (v VersionData) Matches(requestedVersion string) bool {
+	requested, err := version.Parse(requestedVersion)
+	return err == nil && v.Version().HasPrefix(requested)
 }
 
 // Binary represents a downloadable binary
 type Binary struct {
-	OS           string  `json:"os"`
-	Architecture string  `json:"architecture"`
-	ImageType    string  `json:"image_type"`
-	Package      Package `json:"package"`
+	OS            string    `json:"os"`
+	Architecture  string    `json:"architecture"`
+	ImageType     string    `json:"image_type"`
+	CLib          string    `json:"c_lib"`    // glibc or musl, empty for other systems
+	JVMImpl       string    `json:"jvm_impl"` // e.g. hotspot
+	HeapSize      string    `json:"heap_size"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	DownloadCount int64     `json:"download_count"`
+	Package       Package   `json:"package"`
+}
+
+// Platform returns the platform a binary was built for. Adoptium publishes musl
+// builds as the alpine-linux OS.
+func (b Binary) Platform() Platform {
+	platform := Platform{OS: b.OS, Arch: b.Architecture}
+	if b.OS == "alpine-linux" {
+		platform.OS = "linux"
+		platform.LibC = LibCMusl
+	} else if b.OS == "linux" {
+		platform.LibC = LibCGlibc
+	}
+	return platform
 }
 
 // Package contains download information
 type Package struct {
-	Name string `json:"name"`
-	Link string `json:"link"`
-	Size int64  `json:"size"`
+	Name          string `json:"name"`
+	Link          string `json:"link"`
+	Size          int64  `json:"size"`
+	Checksum      string `json:"checksum"`       // SHA-256 of the archive
+	ChecksumLink  string `json:"checksum_link"`  // URL of the published .sha256.txt file
+	SignatureLink string `json:"signature_link"` // URL of the detached GPG signature
+	DownloadCount int64  `json:"download_count"`
+}
+
+// DownloadRequest describes the build to look up
+type DownloadRequest struct {
+	Version   string // e.g. 21, 17.0.8 or 25-ea
+	ImageType string // One of ImageTypes, jdk when empty
+	OS        string // Target OS in Adoptium naming, the current OS when empty
+	Arch      string // Target architecture in Adoptium naming, the current one when empty
+	LibC      string // glibc or musl for Linux builds, detected when empty
+}
+
+// ImageTypeOrDefault returns the requested image type, jdk when none was given
+func (r DownloadRequest) ImageTypeOrDefault() string {
+	if r.ImageType == "" {
+		return ImageTypeJDK
+	}
+	return r.ImageType
+}
+
+// Platform returns the requested target platform, filling in the current system for
+// anything not given. The C library is only detected for builds of the current OS;
+// other Linux targets default to glibc.
+func (r DownloadRequest) Platform() Platform {
+	platform := Platform{OS: r.OS, Arch: r.Arch, LibC: r.LibC}
+	if platform.OS == "" {
+		platform.OS = OSName()
+	}
+	if platform.Arch == "" {
+		platform.Arch = Architecture()
+	}
+
+	switch {
+	case platform.OS != "linux":
+		platform.LibC = ""
+	case platform.LibC != "":
+	case platform.OS == OSName():
+		platform.LibC = LibC()
+	default:
+		platform.LibC = LibCGlibc
+	}
+
+	return platform
 }
 
 // DownloadInfo contains information needed to download a JDK
 type DownloadInfo struct {
-	URL      string
-	Filename string
-	Size     int64
+	URL       string
+	Filename  string
+	Size      int64
+	Vendor    string      // Name of the distribution, e.g. temurin
+	Version   VersionData // Exact version of the build
+	ImageType string      // Image type of the archive, e.g. jdk or jre
+	Platform  Platform    // Platform the archive was built for
+	// Checksum is the expected SHA-256 of the archive, hex encoded
+	Checksum string
+	// ChecksumURL points to a published checksum file, used when Checksum is empty
+	ChecksumURL string
+	// SignatureURL points to a detached GPG signature of the archive, if published
+	SignatureURL string
+	// Mirrors are alternative URLs for the same archive, tried in order before URL
+	Mirrors []string
+}
+
+// Vendor describes a JDK distribution and the name used to select it
+type Vendor struct {
+	Name        string   // Identifier used with --vendor, e.g. "temurin"
+	DisplayName string   // Human readable name
+	Aliases     []string // Alternative names accepted for Name
+	Homepage    string
 }
 
-// NewClient creates a new Adoptium API client
+// NewClient creates a new Adoptium API client using the public endpoints
 func NewClient() *Client {
+	return NewClientWithOptions(Options{})
+}
+
+// NewClientWithOptions creates a new Adoptium API client with custom endpoints
+func NewClientWithOptions(opts Options) *Client {
+	baseURL := strings.TrimSuffix(opts.BaseURL, "/")
+	if baseURL == "" {
+		baseURL = adoptiumAPIBase
+	}
+
 	return &Client{
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		httpClient:   httpclient.New(),
+		baseURL:      baseURL,
+		downloadHost: strings.TrimSuffix(opts.DownloadHost, "/"),
 	}
 }
 
-// GetAvailableReleases fetches available JDK releases from Adoptium
-func (c *Client) GetAvailableReleases() ([]Release, error) {
-	url := fmt.Sprintf("%s/info/available_releases", adoptiumAPIBase)
-	
-	resp, err := c.httpClient.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch available releases: %w", err)
-	}
-	defer resp.Body.Close()
+// SetHTTPClient replaces the client used for API requests, e.g. with a caching one
+func (c *Client) SetHTTPClient(client *http.Client) {
+	c.httpClient = client
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+// Vendor returns the distribution served by the Adoptium API
+func (c *Client) Vendor() Vendor {
+	return Vendor{
+		Name:        "temurin",
+		DisplayName: "Eclipse Temurin",
+		Aliases:     []string{"adoptium"},
+		Homepage:    "https://adoptium.net",
 	}
+}
+
+// GetAvailableReleases fetches every GA build published on Adoptium, newest first
+func (c *Client) GetAvailableReleases(ctx context.Context) ([]Release, error) {
+	return c.fetchReleaseVersions(ctx, releaseTypeGA, 0)
+}
+
+// GetMajorReleases fetches the GA builds of a single major version, newest first
+func (c *Client) GetMajorReleases(ctx context.Context, major int) ([]Release, error) {
+	return c.fetchReleaseVersions(ctx, releaseTypeGA, major)
+}
 
+// GetPreReleases fetches the early-access builds published on Adoptium, newest first
+func (c *Client) GetPreReleases(ctx context.Context) ([]Release, error) {
+	return c.fetchReleaseVersions(ctx, releaseTypeEA, 0)
+}
+
+// GetLTSReleases returns the major versions Adoptium lists as long-term support releases
+func (c *Client) GetLTSReleases(ctx context.Context) ([]int, error) {
 	var apiResponse struct {
-		AvailableReleases []int `json:"available_releases"`
+		AvailableLTSReleases []int `json:"available_lts_releases"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
-		return nil, fmt.Errorf("failed to decode API response: %w", err)
+	found, err := c.getJSON(ctx, c.baseURL+"/info/available_releases", &apiResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch available releases: %w", err)
 	}
+	if !found || len(apiResponse.AvailableLTSReleases) == 0 {
+		return nil, fmt.Errorf("no LTS releases listed by %s", c.baseURL)
+	}
+
+	return apiResponse.AvailableLTSReleases, nil
+}
 
-	// Convert to Release structs
+// fetchReleaseVersions pages through the release versions of the given release type,
+// limited to one major version unless major is 0
+func (c *Client) fetchReleaseVersions(ctx context.Context, releaseType string, major int) ([]Release, error) {
 	var releases []Release
-	for _, version := range apiResponse.AvailableReleases {
-		releases = append(releases, Release{
-			VersionData: VersionData{
-				Major: version,
-			},
-		})
+	seen := make(map[VersionData]bool)
+
+	for page := 0; page < maxPages; page++ {
+		query := url.Values{}
+		query.Set("release_type", releaseType)
+		query.Set("vendor", "eclipse")
+		query.Set("project", "jdk")
+		query.Set("image_type", "jdk")
+		query.Set("sort_order", "DESC")
+		query.Set("page_size", strconv.Itoa(releaseVersionsPageSize))
+		query.Set("page", strconv.Itoa(page))
+		if major > 0 {
+			// Maven version range, e.g. [17,18)
+			query.Set("version", fmt.Sprintf("[%d,%d)", major, major+1))
+		}
+
+		apiURL := fmt.Sprintf("%s/info/release_versions?%s", c.baseURL, query.Encode())
+
+		var apiResponse struct {
+			Versions []VersionData `json:"versions"`
+		}
+
+		found, err := c.getJSON(ctx, apiURL, &apiResponse)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch release versions: %w", err)
+		}
+		if !found {
+			break // Past the last page
+		}
+
+		for _, versionData := range apiResponse.Versions {
+			// The same build can be listed more than once (e.g. respins)
+			key := VersionData{
+				Major:    versionData.Major,
+				Minor:    versionData.Minor,
+				Security: versionData.Security,
+				Patch:    versionData.Patch,
+				Build:    versionData.Build,
+				Pre:      versionData.Pre,
+			}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			releases = append(releases, Release{
+				VersionData: versionData,
+				PreRelease:  releaseType == releaseTypeEA,
+			})
+		}
+
+		if len(apiResponse.Versions) < releaseVersionsPageSize {
+			break
+		}
 	}
 
+	sort.SliceStable(releases, func(i, j int) bool {
+		return releases[i].VersionData.Compare(releases[j].VersionData) > 0
+	})
+
 	return releases, nil
 }
 
-// GetDownloadInfo gets download information for a specific JDK version
-func (c *Client) GetDownloadInfo(version string) (*DownloadInfo, error) {
+// GetDownloadInfo gets download information for a specific JDK version and image type
+func (c *Client) GetDownloadInfo(ctx context.Context, request DownloadRequest) (*DownloadInfo, error) {
+	// Early-access builds are requested as e.g. 25-ea
+	requested, releaseType := splitReleaseType(request.Version)
+	imageType := request.ImageTypeOrDefault()
+
 	// Parse version to get major version
-	majorVersion, err := c.parseMajorVersion(version)
+	majorVersion, err := c.parseMajorVersion(requested)
 	if err != nil {
 		return nil, fmt.Errorf("invalid version format: %w", err)
 	}
 
-	// Get current platform info
-	osName := c.getOSName()
-	arch := c.getArchitecture()
+	// musl builds are published as a separate OS
+	platform := request.Platform()
+	osName := platform.OS
+	if platform.LibC == LibCMusl {
+		osName = "alpine-linux"
+	}
+	arch := platform.Arch
+
+	// Older patch levels are further down the list, so page through it
+	// until the requested version shows up
+	for page := 0; page < maxPages; page++ {
+		query := url.Values{}
+		// Sources are not tied to a platform
+		if imageType != ImageTypeSources {
+			query.Set("os", osName)
+			query.Set("architecture", arch)
+		}
+		query.Set("image_type", imageType)
+		query.Set("sort_order", "DESC")
+		query.Set("page_size", strconv.Itoa(assetsPageSize))
+		query.Set("page", strconv.Itoa(page))
+
+		apiURL := fmt.Sprintf("%s/assets/feature_releases/%d/%s?%s", c.baseURL, majorVersion, releaseType, query.Encode())
+
+		var releases []Release
+		found, err := c.getJSON(ctx, apiURL, &releases)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch release info: %w", err)
+		}
+		if !found {
+			break
+		}
+
+		downloadInfo, err := c.findDownload(releases, requested, osName, arch, imageType)
+		if err != nil {
+			return nil, err
+		}
+		if downloadInfo != nil {
+			downloadInfo.Platform = platform
+			return downloadInfo, nil
+		}
+
+		if len(releases) < assetsPageSize || !c.isSpecificVersion(requested) {
+			break
+		}
+	}
+
+	if releaseType == releaseTypeEA {
+		return nil, fmt.Errorf("no suitable early-access %s found for version %s on %s/%s", imageType, requested, osName, arch)
+	}
+	return nil, fmt.Errorf("no suitable %s found for version %s on %s/%s", imageType, requested, osName, arch)
+}
 
-	// Fetch release information
-	url := fmt.Sprintf("%s/assets/feature_releases/%d/ga", adoptiumAPIBase, majorVersion)
-	
-	resp, err := c.httpClient.Get(url)
+// GetRelease returns the newest release matching a version, with the binaries of
+// every platform. It is used to show release details rather than to download.
+func (c *Client) GetRelease(ctx context.Context, request DownloadRequest) (*Release, error) {
+	requested, releaseType := splitReleaseType(request.Version)
+	imageType := request.ImageTypeOrDefault()
+
+	majorVersion, err := c.parseMajorVersion(requested)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch release info: %w", err)
+		return nil, fmt.Errorf("invalid version format: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+	for page := 0; page < maxPages; page++ {
+		query := url.Values{}
+		query.Set("image_type", imageType)
+		query.Set("sort_order", "DESC")
+		query.Set("page_size", strconv.Itoa(assetsPageSize))
+		query.Set("page", strconv.Itoa(page))
+
+		apiURL := fmt.Sprintf("%s/assets/feature_releases/%d/%s?%s", c.baseURL, majorVersion, releaseType, query.Encode())
+
+		var releases []Release
+		found, err := c.getJSON(ctx, apiURL, &releases)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch release info: %w", err)
+		}
+		if !found {
+			break
+		}
+
+		for i := range releases {
+			if !c.isSpecificVersion(requested) || c.matchesVersion(releases[i], requested) {
+				return &releases[i], nil
+			}
+		}
+
+		if len(releases) < assetsPageSize || !c.isSpecificVersion(requested) {
+			break
+		}
 	}
 
-	var releases []Release
-	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
-		return nil, fmt.Errorf("failed to decode API response: %w", err)
+	return nil, fmt.Errorf("no %s release found for version %s", imageType, request.Version)
+}
+
+// splitReleaseType separates an early-access suffix from a requested version,
+// returning the plain version and the Adoptium release type ("ga" or "ea")
+func splitReleaseType(version string) (string, string) {
+	if base, ok := strings.CutSuffix(version, EarlyAccessSuffix); ok {
+		return base, releaseTypeEA
 	}
+	return version, releaseTypeGA
+}
 
+// findDownload returns the first binary matching the version, platform and image type, or nil
+func (c *Client) findDownload(releases []Release, version, osName, arch, imageType string) (*DownloadInfo, error) {
 	// Find the best matching release
 	for _, release := range releases {
 		// Skip if specific version requested and doesn't match
@@ -139,96 +495,110 @@ func (c *Client) GetDownloadInfo(version string) (*DownloadInfo, error) {
 
 		// Find matching binary for current platform
 		for _, binary := range release.Binaries {
-			if binary.OS == osName && 
-			   binary.Architecture == arch && 
-			   binary.ImageType == "jdk" {
+			platformMatches := imageType == ImageTypeSources ||
+				(binary.OS == osName && binary.Architecture == arch)
+			if platformMatches && binary.ImageType == imageType {
+				downloadURL, err := c.rewriteDownloadURL(binary.Package.Link)
+				if err != nil {
+					return nil, err
+				}
+				checksumURL, err := c.rewriteDownloadURL(binary.Package.ChecksumLink)
+				if err != nil {
+					return nil, err
+				}
+				signatureURL, err := c.rewriteDownloadURL(binary.Package.SignatureLink)
+				if err != nil {
+					return nil, err
+				}
+
 				return &DownloadInfo{
-					URL:      binary.Package.Link,
-					Filename: binary.Package.Name,
-					Size:     binary.Package.Size,
+					URL:          downloadURL,
+					Filename:     binary.Package.Name,
+					Size:         binary.Package.Size,
+					Vendor:       c.Vendor().Name,
+					Version:      release.VersionData,
+					ImageType:    imageType,
+					Checksum:     binary.Package.Checksum,
+					ChecksumURL:  checksumURL,
+					SignatureURL: signatureURL,
 				}, nil
 			}
 		}
 	}
 
-	return nil, fmt.Errorf("no suitable JDK found for version %s on %s/%s", version, osName, arch)
+	return nil, nil
 }
 
-// parseMajorVersion extracts the major version number from a version string
-func (c *Client) parseMajorVersion(version string) (int, error) {
-	parts := strings.Split(version, ".")
-	if len(parts) == 0 {
-		return 0, fmt.Errorf("empty version string")
+// getJSON fetches an API URL and decodes the JSON response into v.
+// It returns false without error if the API answers 404, which Adoptium
+// uses for pages past the end of a list.
+func (c *Client) getJSON(ctx context.Context, apiURL string, v interface{}) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	major, err := strconv.Atoi(parts[0])
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return 0, fmt.Errorf("invalid major version: %s", parts[0])
+		return false, err
 	}
+	defer resp.Body.Close()
 
-	return major, nil
-}
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
 
-// isSpecificVersion checks if the version string specifies more than just major version
-func (c *Client) isSpecificVersion(version string) bool {
-	return strings.Contains(version, ".")
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return false, fmt.Errorf("failed to decode API response: %w", err)
+	}
+
+	return true, nil
 }
 
-// matchesVersion checks if a release matches the requested version
-func (c *Client) matchesVersion(release Release, requestedVersion string) bool {
-	parts := strings.Split(requestedVersion, ".")
-	
-	// Check major version
-	if len(parts) >= 1 {
-		major, _ := strconv.Atoi(parts[0])
-		if release.VersionData.Major != major {
-			return false
-		}
+// rewriteDownloadURL points a package link at the configured download host
+func (c *Client) rewriteDownloadURL(link string) (string, error) {
+	if c.downloadHost == "" || link == "" {
+		return link, nil
 	}
 
-	// Check minor version
-	if len(parts) >= 2 {
-		minor, _ := strconv.Atoi(parts[1])
-		if release.VersionData.Minor != minor {
-			return false
-		}
+	original, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("invalid download link %s: %w", link, err)
 	}
 
-	// Check security version
-	if len(parts) >= 3 {
-		security, _ := strconv.Atoi(parts[2])
-		if release.VersionData.Security != security {
-			return false
-		}
+	host, err := url.Parse(c.downloadHost)
+	if err != nil || host.Host == "" {
+		return "", fmt.Errorf("invalid download host: %s", c.downloadHost)
 	}
 
-	return true
+	original.Scheme = host.Scheme
+	original.Host = host.Host
+	original.Path = host.Path + original.Path
+
+	return original.String(), nil
 }
 
-// getOSName returns the OS name in Adoptium API format
-func (c *Client) getOSName() string {
-	switch runtime.GOOS {
-	case "darwin":
-		return "mac"
-	case "windows":
-		return "windows"
-	case "linux":
-		return "linux"
-	default:
-		return runtime.GOOS
+// parseMajorVersion extracts the major version number from a version string
+func (c *Client) parseMajorVersion(versionStr string) (int, error) {
+	v, err := version.Parse(versionStr)
+	if err != nil {
+		return 0, err
 	}
+
+	return v.Major, nil
 }
 
-// getArchitecture returns the architecture in Adoptium API format
-func (c *Client) getArchitecture() string {
-	switch runtime.GOARCH {
-	case "amd64":
-		return "x64"
-	case "arm64":
-		return "aarch64"
-	case "386":
-		return "x32"
-	default:
-		return runtime.GOARCH
-	}
+// isSpecificVersion checks if the version string specifies more than just major version
+func (c *Client) isSpecificVersion(versionStr string) bool {
+	v, err := version.Parse(versionStr)
+	return err == nil && (v.Precision() > 1 || v.Build > 0)
+}
+
+// matchesVersion checks if a release matches the requested version
+func (c *Client) matchesVersion(release Release, requestedVersion string) bool {
+	return release.VersionData.Matches(requestedVersion)
 }
@@ -1,6 +1,11 @@
 package adoptium
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -72,9 +77,8 @@ func TestIsSpecificVersion(t *testing.T) {
 	}
 }
 
-func TestGetOSName(t *testing.T) {
-	client := NewClient()
-	osName := client.getOSName()
+func TestOSName(t *testing.T) {
+	osName := OSName()
 	
 	if osName == "" {
 		t.Fatal("OS name should not be empty")
@@ -95,9 +99,8 @@ func TestGetOSName(t *testing.T) {
 	}
 }
 
-func TestGetArchitecture(t *testing.T) {
-	client := NewClient()
-	arch := client.getArchitecture()
+func TestArchitecture(t *testing.T) {
+	arch := Architecture()
 	
 	if arch == "" {
 		t.Fatal("Architecture should not be empty")
@@ -149,3 +152,447 @@ func TestMatchesVersion(t *testing.T) {
 		}
 	}
 }
+
+func TestGetDownloadInfo_CustomEndpoints(t *testing.T) {
+	client := NewClient()
+	releases := []Release{
+		{
+			VersionData: VersionData{Major: 21, Security: 2, Build: 13},
+			Binaries: []Binary{
+				{
+					OS:           OSName(),
+					Architecture: Architecture(),
+					ImageType:    "jdk",
+					Package: Package{
+						Name:         "OpenJDK21U-jdk.tar.gz",
+						Link:         "https://github.com/adoptium/temurin21-binaries/releases/download/jdk-21.0.2/OpenJDK21U-jdk.tar.gz",
+						Checksum:     "abc123",
+						ChecksumLink: "https://github.com/adoptium/temurin21-binaries/releases/download/jdk-21.0.2/OpenJDK21U-jdk.tar.gz.sha256.txt",
+					},
+				},
+			},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/assets/feature_releases/21/ga" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(releases)
+	}))
+	defer server.Close()
+
+	client = NewClientWithOptions(Options{
+		BaseURL:      server.URL + "/api/v3/",
+		DownloadHost: "https://mirror.example.com/github",
+	})
+
+	info, err := client.GetDownloadInfo(context.Background(), DownloadRequest{Version: "21"})
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+
+	expected := "https://mirror.example.com/github/adoptium/temurin21-binaries/releases/download/jdk-21.0.2/OpenJDK21U-jdk.tar.gz"
+	if info.URL != expected {
+		t.Fatalf("Expected download URL %s, got %s", expected, info.URL)
+	}
+
+	if info.Checksum != "abc123" || info.ChecksumURL == "" {
+		t.Fatalf("Expected checksum information to be passed on, got %+v", info)
+	}
+}
+
+func TestGetDownloadInfo_EarlyAccess(t *testing.T) {
+	client := NewClient()
+	releases := []Release{
+		{
+			VersionData: VersionData{Major: 25, Pre: "beta", Build: 30},
+			Binaries: []Binary{
+				{
+					OS:           OSName(),
+					Architecture: Architecture(),
+					ImageType:    "jdk",
+					Package:      Package{Name: "OpenJDK25U-jdk-ea.tar.gz", Link: "https://example.com/OpenJDK25U-jdk-ea.tar.gz"},
+				},
+			},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/assets/feature_releases/25/ea" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(releases)
+	}))
+	defer server.Close()
+
+	client = NewClientWithOptions(Options{BaseURL: server.URL})
+
+	info, err := client.GetDownloadInfo(context.Background(), DownloadRequest{Version: "25-ea"})
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+	if info.Filename != "OpenJDK25U-jdk-ea.tar.gz" {
+		t.Errorf("Expected early-access archive, got %s", info.Filename)
+	}
+
+	if _, err := client.GetDownloadInfo(context.Background(), DownloadRequest{Version: "25"}); err == nil {
+		t.Error("Expected GA lookup to ignore early-access builds")
+	}
+}
+
+func TestGetDownloadInfo_ImageType(t *testing.T) {
+	client := NewClient()
+	binary := func(imageType, osName, name string) Binary {
+		return Binary{
+			OS:           osName,
+			Architecture: Architecture(),
+			ImageType:    imageType,
+			Package:      Package{Name: name, Link: "https://example.com/" + name},
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		imageType := r.URL.Query().Get("image_type")
+		release := Release{VersionData: VersionData{Major: 21, Security: 2, Build: 13}}
+		switch imageType {
+		case ImageTypeJRE:
+			release.Binaries = []Binary{binary(ImageTypeJRE, OSName(), "OpenJDK21U-jre.tar.gz")}
+		case ImageTypeSources:
+			if r.URL.Query().Get("os") != "" {
+				t.Errorf("Sources should not be filtered by platform: %s", r.URL.RawQuery)
+			}
+			release.Binaries = []Binary{binary(ImageTypeSources, "any", "OpenJDK21U-sources.tar.gz")}
+		}
+		json.NewEncoder(w).Encode([]Release{release})
+	}))
+	defer server.Close()
+
+	client = NewClientWithOptions(Options{BaseURL: server.URL})
+
+	info, err := client.GetDownloadInfo(context.Background(), DownloadRequest{Version: "21", ImageType: ImageTypeJRE})
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+	if info.Filename != "OpenJDK21U-jre.tar.gz" || info.ImageType != ImageTypeJRE {
+		t.Errorf("Expected JRE archive, got %+v", info)
+	}
+
+	info, err = client.GetDownloadInfo(context.Background(), DownloadRequest{Version: "21", ImageType: ImageTypeSources})
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+	if info.Filename != "OpenJDK21U-sources.tar.gz" {
+		t.Errorf("Expected sources archive, got %+v", info)
+	}
+
+	if _, err := client.GetDownloadInfo(context.Background(), DownloadRequest{Version: "21", ImageType: ImageTypeDebugImage}); err == nil {
+		t.Error("Expected error when no debug image is published")
+	}
+}
+
+func TestGetDownloadInfo_Musl(t *testing.T) {
+	client := NewClient()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("os") != "alpine-linux" {
+			t.Errorf("Expected alpine-linux binaries to be requested, got %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode([]Release{{
+			VersionData: VersionData{Major: 21, Security: 2, Build: 13},
+			Binaries: []Binary{{
+				OS:           "alpine-linux",
+				Architecture: "aarch64",
+				ImageType:    ImageTypeJDK,
+				Package:      Package{Name: "OpenJDK21U-jdk_x64_alpine-linux.tar.gz", Link: "https://example.com/alpine.tar.gz"},
+			}},
+		}})
+	}))
+	defer server.Close()
+
+	client = NewClientWithOptions(Options{BaseURL: server.URL})
+	request := DownloadRequest{Version: "21", OS: "linux", Arch: "aarch64", LibC: LibCMusl}
+	info, err := client.GetDownloadInfo(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+	if info.Platform != (Platform{OS: "linux", Arch: "aarch64", LibC: LibCMusl}) {
+		t.Errorf("Expected linux-aarch64 musl build, got %+v", info)
+	}
+}
+
+func TestGetPreReleases(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("release_type") != "ea" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string][]VersionData{
+			"versions": {{Major: 25, Pre: "beta", Build: 30}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(Options{BaseURL: server.URL})
+	releases, err := client.GetPreReleases(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get pre-releases: %v", err)
+	}
+	if len(releases) != 1 || !releases[0].PreRelease {
+		t.Fatalf("Expected one pre-release, got %+v", releases)
+	}
+}
+
+func TestGetLTSReleases(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/info/available_releases" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"available_lts_releases": [8, 11, 17, 21, 25], "most_recent_lts": 25}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(Options{BaseURL: server.URL})
+	releases, err := client.GetLTSReleases(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get LTS releases: %v", err)
+	}
+	if len(releases) != 5 || releases[4] != 25 {
+		t.Errorf("Unexpected LTS releases: %v", releases)
+	}
+}
+
+func TestSplitReleaseType(t *testing.T) {
+	tests := []struct {
+		version     string
+		expected    string
+		releaseType string
+	}{
+		{"21", "21", "ga"},
+		{"25-ea", "25", "ea"},
+		{"21.0.2-ea", "21.0.2", "ea"},
+	}
+
+	for _, test := range tests {
+		version, releaseType := splitReleaseType(test.version)
+		if version != test.expected || releaseType != test.releaseType {
+			t.Errorf("splitReleaseType(%s) = %s, %s, expected %s, %s",
+				test.version, version, releaseType, test.expected, test.releaseType)
+		}
+	}
+}
+
+func TestGetAvailableReleases_Paginates(t *testing.T) {
+	var firstPage []VersionData
+	for security := 50; security > 0; security-- {
+		firstPage = append(firstPage, VersionData{Major: 17, Security: security, Build: 7})
+	}
+	// Respins of the same build are listed twice
+	secondPage := []VersionData{
+		{Major: 11, Security: 22, Build: 7},
+		{Major: 11, Security: 22, Build: 7},
+		{Major: 21, Security: 2, Build: 13},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/info/release_versions" || r.URL.Query().Get("release_type") != "ga" {
+			http.NotFound(w, r)
+			return
+		}
+		switch r.URL.Query().Get("page") {
+		case "0":
+			json.NewEncoder(w).Encode(map[string]interface{}{"versions": firstPage})
+		case "1":
+			json.NewEncoder(w).Encode(map[string]interface{}{"versions": secondPage})
+		default:
+			t.Errorf("Unexpected request for page %s", r.URL.Query().Get("page"))
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(Options{BaseURL: server.URL})
+	releases, err := client.GetAvailableReleases(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get releases: %v", err)
+	}
+
+	if len(releases) != 52 {
+		t.Fatalf("Expected 52 distinct releases, got %d", len(releases))
+	}
+
+	first := releases[0].VersionData
+	if first.Major != 21 || first.Security != 2 || first.Build != 13 {
+		t.Errorf("Expected newest release 21.0.2+13 first, got %+v", first)
+	}
+
+	last := releases[len(releases)-1].VersionData
+	if last.Major != 11 || last.Security != 22 {
+		t.Errorf("Expected oldest release 11.0.22 last, got %+v", last)
+	}
+}
+
+func TestGetMajorReleases(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/info/release_versions" || r.URL.Query().Get("version") != "[17,18)" {
+			t.Errorf("Unexpected request %s", r.URL)
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"versions": []VersionData{
+			{Major: 17, Security: 9, Build: 9},
+			{Major: 17, Security: 10, Build: 7},
+		}})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(Options{BaseURL: server.URL})
+	releases, err := client.GetMajorReleases(context.Background(), 17)
+	if err != nil {
+		t.Fatalf("Failed to get releases: %v", err)
+	}
+	if len(releases) != 2 || releases[0].VersionData.Security != 10 {
+		t.Errorf("Expected the 17 releases newest first, got %+v", releases)
+	}
+}
+
+func TestGetAvailableReleases_Cancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No request should be made with a cancelled context")
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := NewClientWithOptions(Options{BaseURL: server.URL})
+	if _, err := client.GetAvailableReleases(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the listing to be cancelled, got %v", err)
+	}
+	if _, err := client.GetLTSReleases(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the LTS lookup to be cancelled, got %v", err)
+	}
+}
+
+func TestGetDownloadInfo_OlderPatchLevel(t *testing.T) {
+	client := NewClient()
+	binary := func(name string) []Binary {
+		return []Binary{{
+			OS:           OSName(),
+			Architecture: Architecture(),
+			ImageType:    "jdk",
+			Package:      Package{Name: name, Link: "https://example.com/" + name},
+		}}
+	}
+
+	var firstPage []Release
+	for security := 30; security > 10; security-- {
+		firstPage = append(firstPage, Release{VersionData: VersionData{Major: 17, Security: security}, Binaries: binary("new.tar.gz")})
+	}
+	secondPage := []Release{{VersionData: VersionData{Major: 17, Security: 8}, Binaries: binary("17.0.8.tar.gz")}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "0":
+			json.NewEncoder(w).Encode(firstPage)
+		case "1":
+			json.NewEncoder(w).Encode(secondPage)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client = NewClientWithOptions(Options{BaseURL: server.URL})
+
+	info, err := client.GetDownloadInfo(context.Background(), DownloadRequest{Version: "17.0.8"})
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+	if info.Filename != "17.0.8.tar.gz" {
+		t.Fatalf("Expected 17.0.8 archive from the second page, got %s", info.Filename)
+	}
+
+	if _, err := client.GetDownloadInfo(context.Background(), DownloadRequest{Version: "17.0.9"}); err == nil {
+		t.Fatal("Expected error for a version that is not published")
+	}
+}
+
+func TestVersionDataCompare(t *testing.T) {
+	tests := []struct {
+		a, b     VersionData
+		expected int
+	}{
+		{VersionData{Major: 17, Security: 10}, VersionData{Major: 17, Security: 8}, 1},
+		{VersionData{Major: 8, Security: 402}, VersionData{Major: 21}, -1},
+		{VersionData{Major: 21, Security: 2, Build: 13}, VersionData{Major: 21, Security: 2, Build: 13}, 0},
+		{VersionData{Major: 21, Security: 2, Build: 12}, VersionData{Major: 21, Security: 2, Build: 13}, -1},
+		{VersionData{Major: 25, Pre: "ea", Build: 30}, VersionData{Major: 25, Build: 1}, -1},
+	}
+
+	for _, test := range tests {
+		if result := test.a.Compare(test.b); result != test.expected {
+			t.Errorf("%+v.Compare(%+v) = %d, expected %d", test.a, test.b, result, test.expected)
+		}
+	}
+}
+
+func TestGetRelease(t *testing.T) {
+	// Trimmed response of /v3/assets/feature_releases/17/ga without os/architecture filters
+	response := `[
+		{
+			"release_name": "jdk-17.0.10+7",
+			"release_link": "https://github.com/adoptium/temurin17-binaries/releases/tag/jdk-17.0.10%2B7",
+			"release_type": "ga",
+			"vendor": "eclipse",
+			"timestamp": "2024-01-17T11:37:47Z",
+			"release_notes": {"name": "jdk-17.0.10+7.json", "link": "https://example.com/notes.json"},
+			"version_data": {"major": 17, "minor": 0, "security": 10, "build": 7},
+			"binaries": [
+				{"os": "linux", "architecture": "x64", "image_type": "jdk", "c_lib": "glibc", "package": {"name": "a.tar.gz", "size": 100}},
+				{"os": "alpine-linux", "architecture": "x64", "image_type": "jdk", "c_lib": "musl", "package": {"name": "b.tar.gz"}},
+				{"os": "windows", "architecture": "x64", "image_type": "jre", "package": {"name": "c.zip"}}
+			]
+		},
+		{
+			"release_name": "jdk-17.0.9+9",
+			"version_data": {"major": 17, "minor": 0, "security": 9, "build": 9}
+		}
+	]`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/assets/feature_releases/17/ga" || r.URL.Query().Get("os") != "" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(Options{BaseURL: server.URL})
+
+	release, err := client.GetRelease(context.Background(), DownloadRequest{Version: "17"})
+	if err != nil {
+		t.Fatalf("Failed to get release: %v", err)
+	}
+	if release.ReleaseName != "jdk-17.0.10+7" || release.ReleaseNotes == nil || release.Timestamp.Year() != 2024 {
+		t.Errorf("Expected release details of the latest 17 release, got %+v", release)
+	}
+	if len(release.Binaries) != 3 || release.Binaries[0].CLib != "glibc" {
+		t.Errorf("Expected the binaries of every platform, got %+v", release.Binaries)
+	}
+	if platform := release.Binaries[1].Platform(); platform.OS != "linux" || platform.LibC != LibCMusl {
+		t.Errorf("Expected alpine-linux to map to linux with musl, got %+v", platform)
+	}
+
+	release, err = client.GetRelease(context.Background(), DownloadRequest{Version: "17.0.9"})
+	if err != nil || release.ReleaseName != "jdk-17.0.9+9" {
+		t.Errorf("Expected the 17.0.9 release, got %+v (%v)", release, err)
+	}
+
+	if _, err := client.GetRelease(context.Background(), DownloadRequest{Version: "17.0.1"}); err == nil {
+		t.Error("Expected error for a release that is not listed")
+	}
+}
@@ -0,0 +1,168 @@
+package adoptium
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// C library variants of Linux builds
+const (
+	LibCGlibc = "glibc"
+	LibCMusl  = "musl"
+)
+
+// Platform identifies the system a build is made for, in Adoptium API naming
+type Platform struct {
+	OS   string `json:"os"`             // e.g. linux, mac, windows
+	Arch string `json:"arch"`           // e.g. x64, aarch64
+	LibC string `json:"libc,omitempty"` // glibc or musl on Linux, empty elsewhere
+}
+
+// CurrentPlatform returns the platform jdk-manager is running on
+func CurrentPlatform() Platform {
+	return Platform{OS: OSName(), Arch: Architecture(), LibC: LibC()}
+}
+
+// String returns the platform as os-arch, with the C library appended for musl,
+// e.g. linux-x64 or linux-aarch64-musl
+func (p Platform) String() string {
+	name := p.OS + "-" + p.Arch
+	if p.LibC == LibCMusl {
+		name += "-" + LibCMusl
+	}
+	return name
+}
+
+// IsCurrent reports whether builds for the platform run on the current system,
+// including its C library on Linux
+func (p Platform) IsCurrent() bool {
+	return p.OS == OSName() && p.Arch == Architecture() && (p.LibC == "" || p.LibC == LibC())
+}
+
+// VendorOS returns the OS name most distributions use outside the Adoptium API:
+// macos for mac, other names unchanged
+func (p Platform) VendorOS() string {
+	if p.OS == "mac" {
+		return "macos"
+	}
+	return p.OS
+}
+
+// VendorArch returns the architecture name most distributions use outside the
+// Adoptium API: x86 for x32, other names unchanged
+func (p Platform) VendorArch() string {
+	if p.Arch == "x32" {
+		return "x86"
+	}
+	return p.Arch
+}
+
+// ArchiveType returns the archive format distributions publish for the platform
+func (p Platform) ArchiveType() string {
+	if p.OS == "windows" {
+		return "zip"
+	}
+	return "tar.gz"
+}
+
+// osAliases maps accepted OS names to Adoptium API names
+var osAliases = map[string]string{
+	"linux":   "linux",
+	"mac":     "mac",
+	"macos":   "mac",
+	"darwin":  "mac",
+	"windows": "windows",
+	"win":     "windows",
+	"aix":     "aix",
+	"solaris": "solaris",
+}
+
+// archAliases maps accepted architecture names to Adoptium API names
+var archAliases = map[string]string{
+	"x64":     "x64",
+	"amd64":   "x64",
+	"x86_64":  "x64",
+	"aarch64": "aarch64",
+	"arm64":   "aarch64",
+	"x32":     "x32",
+	"x86":     "x32",
+	"386":     "x32",
+	"i386":    "x32",
+	"arm":     "arm",
+	"ppc64le": "ppc64le",
+	"ppc64":   "ppc64",
+	"s390x":   "s390x",
+	"riscv64": "riscv64",
+}
+
+// ParseOS converts an OS name such as darwin or macos into Adoptium API naming
+func ParseOS(name string) (string, error) {
+	if osName, ok := osAliases[strings.ToLower(name)]; ok {
+		return osName, nil
+	}
+	return "", fmt.Errorf("unsupported OS: %s", name)
+}
+
+// ParseArch converts an architecture name such as amd64 or arm64 into Adoptium API naming
+func ParseArch(name string) (string, error) {
+	if arch, ok := archAliases[strings.ToLower(name)]; ok {
+		return arch, nil
+	}
+	return "", fmt.Errorf("unsupported architecture: %s", name)
+}
+
+// OSName returns the current OS name in Adoptium API format.
+// Other providers translate this value into their own naming.
+func OSName() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "mac"
+	case "windows":
+		return "windows"
+	case "linux":
+		return "linux"
+	default:
+		return runtime.GOOS
+	}
+}
+
+// Architecture returns the current architecture in Adoptium API format.
+// Other providers translate this value into their own naming.
+func Architecture() string {
+	switch runtime.GOARCH {
+	case "amd64":
+		return "x64"
+	case "arm64":
+		return "aarch64"
+	case "386":
+		return "x32"
+	default:
+		return runtime.GOARCH
+	}
+}
+
+// LibC returns the C library of the current system: musl or glibc on Linux,
+// empty on other operating systems
+func LibC() string {
+	if runtime.GOOS != "linux" {
+		return ""
+	}
+	return detectLibC("/")
+}
+
+// detectLibC looks for the musl dynamic loader or the Alpine release file below root
+func detectLibC(root string) string {
+	if _, err := os.Stat(filepath.Join(root, "etc", "alpine-release")); err == nil {
+		return LibCMusl
+	}
+
+	loaders, _ := filepath.Glob(filepath.Join(root, "lib", "ld-musl-*.so.1"))
+	if len(loaders) > 0 {
+		return LibCMusl
+	}
+
+	return LibCGlibc
+}
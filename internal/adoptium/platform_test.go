@@ -0,0 +1,106 @@
+package adoptium
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseOSAndArch(t *testing.T) {
+	osTests := map[string]string{"linux": "linux", "darwin": "mac", "macOS": "mac", "win": "windows"}
+	for name, expected := range osTests {
+		if osName, err := ParseOS(name); err != nil || osName != expected {
+			t.Errorf("ParseOS(%s) = %s, %v, expected %s", name, osName, err, expected)
+		}
+	}
+	if _, err := ParseOS("plan9"); err == nil {
+		t.Error("Expected error for an unsupported OS")
+	}
+
+	archTests := map[string]string{"amd64": "x64", "x86_64": "x64", "arm64": "aarch64", "386": "x32"}
+	for name, expected := range archTests {
+		if arch, err := ParseArch(name); err != nil || arch != expected {
+			t.Errorf("ParseArch(%s) = %s, %v, expected %s", name, arch, err, expected)
+		}
+	}
+	if _, err := ParseArch("mips"); err == nil {
+		t.Error("Expected error for an unsupported architecture")
+	}
+}
+
+func TestDownloadRequestPlatform(t *testing.T) {
+	current := DownloadRequest{}.Platform()
+	if current != CurrentPlatform() {
+		t.Errorf("Expected the current platform by default, got %+v", current)
+	}
+
+	windows := DownloadRequest{OS: "windows", Arch: "x64", LibC: LibCMusl}.Platform()
+	if windows != (Platform{OS: "windows", Arch: "x64"}) {
+		t.Errorf("Expected no C library for Windows, got %+v", windows)
+	}
+
+	if OSName() != "linux" {
+		linux := DownloadRequest{OS: "linux", Arch: "aarch64"}.Platform()
+		if linux.LibC != LibCGlibc {
+			t.Errorf("Expected glibc for a foreign Linux target, got %+v", linux)
+		}
+	}
+
+	if name := (Platform{OS: "linux", Arch: "x64", LibC: LibCMusl}).String(); name != "linux-x64-musl" {
+		t.Errorf("Unexpected platform name %s", name)
+	}
+
+	if !CurrentPlatform().IsCurrent() {
+		t.Error("Expected the current platform to be current")
+	}
+	if OSName() == "linux" {
+		otherLibC := CurrentPlatform()
+		otherLibC.LibC = LibCMusl
+		if LibC() == LibCMusl {
+			otherLibC.LibC = LibCGlibc
+		}
+		if otherLibC.IsCurrent() {
+			t.Errorf("Expected %s builds not to be current", otherLibC.LibC)
+		}
+	}
+}
+
+func TestVendorNames(t *testing.T) {
+	mac := Platform{OS: "mac", Arch: "aarch64"}
+	if mac.VendorOS() != "macos" || mac.VendorArch() != "aarch64" || mac.ArchiveType() != "tar.gz" {
+		t.Errorf("Unexpected vendor names %s/%s/%s for %s", mac.VendorOS(), mac.VendorArch(), mac.ArchiveType(), mac)
+	}
+
+	windows := Platform{OS: "windows", Arch: "x32"}
+	if windows.VendorOS() != "windows" || windows.VendorArch() != "x86" || windows.ArchiveType() != "zip" {
+		t.Errorf("Unexpected vendor names %s/%s/%s for %s", windows.VendorOS(), windows.VendorArch(), windows.ArchiveType(), windows)
+	}
+}
+
+func TestDetectLibC(t *testing.T) {
+	root := t.TempDir()
+	if libc := detectLibC(root); libc != LibCGlibc {
+		t.Errorf("Expected glibc without a musl loader, got %s", libc)
+	}
+
+	if err := os.MkdirAll(filepath.Join(root, "lib"), 0755); err != nil {
+		t.Fatalf("Failed to create lib directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "lib", "ld-musl-x86_64.so.1"), nil, 0755); err != nil {
+		t.Fatalf("Failed to create musl loader: %v", err)
+	}
+	if libc := detectLibC(root); libc != LibCMusl {
+		t.Errorf("Expected musl with a musl loader, got %s", libc)
+	}
+
+	alpine := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(alpine, "etc"), 0755); err != nil {
+		t.Fatalf("Failed to create etc directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(alpine, "etc", "alpine-release"), []byte("3.20.0\n"), 0644); err != nil {
+		t.Fatalf("Failed to create alpine-release: %v", err)
+	}
+	if libc := detectLibC(alpine); libc != LibCMusl {
+		t.Errorf("Expected musl on Alpine, got %s", libc)
+	}
+}
@@ -0,0 +1,226 @@
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultTTL is how long cached API responses are used without revalidation
+const DefaultTTL = 6 * time.Hour
+
+// Mode controls how the cache uses the network
+type Mode int
+
+const (
+	// ModeDefault serves fresh entries from the cache and revalidates stale ones
+	ModeDefault Mode = iota
+	// ModeRefresh revalidates every entry with the server
+	ModeRefresh
+	// ModeOffline never uses the network and serves whatever is cached
+	ModeOffline
+)
+
+// ErrNotCached is returned in offline mode for requests without a cached response
+var ErrNotCached = errors.New("no cached response available offline")
+
+// entry is a cached response as stored on disk
+type entry struct {
+	URL          string      `json:"url"`
+	StatusCode   int         `json:"status_code"`
+	Header       http.Header `json:"header"`
+	Body         []byte      `json:"body"`
+	ETag         string      `json:"etag,omitempty"`
+	LastModified string      `json:"last_modified,omitempty"`
+	FetchedAt    time.Time   `json:"fetched_at"`
+}
+
+// Transport is an http.RoundTripper that caches GET responses of metadata APIs
+// in a directory, revalidating them with ETag and Last-Modified. When the
+// network fails, a cached response is served no matter its age.
+type Transport struct {
+	dir  string
+	ttl  time.Duration
+	mode Mode
+	base http.RoundTripper
+	now  func() time.Time
+
+	mu     sync.Mutex
+	oldest time.Time // FetchedAt of the oldest response served from the cache
+}
+
+// New creates a caching transport storing responses in dir. Requests that go
+// to the network use base, or http.DefaultTransport when base is nil.
+func New(dir string, ttl time.Duration, mode Mode, base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &Transport{
+		dir:  dir,
+		ttl:  ttl,
+		mode: mode,
+		base: base,
+		now:  time.Now,
+	}
+}
+
+// CachedSince returns when the oldest response served from the cache without
+// revalidation was fetched, or the zero time if everything came from the server
+func (t *Transport) CachedSince() time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.oldest
+}
+
+// RoundTrip serves a request from the cache or the network
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.base.RoundTrip(req)
+	}
+
+	path := t.entryPath(req)
+	cached := t.load(path)
+
+	switch {
+	case t.mode == ModeOffline:
+		if cached == nil {
+			return nil, fmt.Errorf("%w: %s", ErrNotCached, req.URL)
+		}
+		return t.serve(cached, req, true), nil
+	case t.mode == ModeDefault && cached != nil && t.now().Sub(cached.FetchedAt) < t.ttl:
+		return t.serve(cached, req, true), nil
+	}
+
+	revalidation := req.Clone(req.Context())
+	if cached != nil {
+		if cached.ETag != "" {
+			revalidation.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			revalidation.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(revalidation)
+	if err != nil {
+		// Offline or unreachable: fall back to what we have
+		if cached != nil && req.Context().Err() == nil {
+			return t.serve(cached, req, true), nil
+		}
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		resp.Body.Close()
+		cached.FetchedAt = t.now()
+		t.store(path, cached)
+		return t.serve(cached, req, false), nil
+	case resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNotFound:
+		// Not found is cached as well, paging APIs use it to signal the last page
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		fetched := &entry{
+			URL:          req.URL.String(),
+			StatusCode:   resp.StatusCode,
+			Header:       resp.Header,
+			Body:         body,
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+			FetchedAt:    t.now(),
+		}
+		t.store(path, fetched)
+		return t.serve(fetched, req, false), nil
+	case resp.StatusCode >= http.StatusInternalServerError && cached != nil:
+		resp.Body.Close()
+		return t.serve(cached, req, true), nil
+	}
+
+	return resp, nil
+}
+
+// serve builds a response from a cache entry. Responses that were not just
+// fetched or revalidated are tracked for CachedSince.
+func (t *Transport) serve(e *entry, req *http.Request, fromCache bool) *http.Response {
+	if fromCache {
+		t.mu.Lock()
+		if t.oldest.IsZero() || e.FetchedAt.Before(t.oldest) {
+			t.oldest = e.FetchedAt
+		}
+		t.mu.Unlock()
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// entryPath returns the file a request's response is cached in
+func (t *Transport) entryPath(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.URL.String()))
+	return filepath.Join(t.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// load reads a cache entry. Missing or unreadable entries are treated as not cached.
+func (t *Transport) load(path string) *entry {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil
+	}
+	return &e
+}
+
+// store writes a cache entry. Failing to cache is not an error for the request.
+func (t *Transport) store(path string, e *entry) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(t.dir, 0755); err != nil {
+		return
+	}
+
+	// Write to a temporary file of our own first so that concurrent readers never see
+	// half an entry and concurrent writers do not write into the same file
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+}
@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func get(t *testing.T, client *http.Client, url string) (int, string) {
+	t.Helper()
+
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read body: %v", err)
+	}
+	return resp.StatusCode, string(body)
+}
+
+func TestTransport_Revalidation(t *testing.T) {
+	requests, notModified := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"releases":[21]}`))
+	}))
+	defer server.Close()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	transport := New(t.TempDir(), time.Hour, ModeDefault, nil)
+	transport.now = func() time.Time { return now }
+	client := &http.Client{Transport: transport}
+
+	if status, body := get(t, client, server.URL); status != http.StatusOK || body != `{"releases":[21]}` {
+		t.Fatalf("Unexpected first response: %d %s", status, body)
+	}
+	if !transport.CachedSince().IsZero() {
+		t.Error("A response fetched from the server should not count as cached")
+	}
+
+	// Within the TTL the server is not contacted
+	now = now.Add(30 * time.Minute)
+	if _, body := get(t, client, server.URL); body != `{"releases":[21]}` {
+		t.Fatalf("Unexpected cached body: %s", body)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request within the TTL, got %d", requests)
+	}
+	if since := transport.CachedSince(); !since.Equal(now.Add(-30 * time.Minute)) {
+		t.Errorf("Unexpected cache time: %v", since)
+	}
+
+	// After the TTL the entry is revalidated with its ETag
+	now = now.Add(time.Hour)
+	if status, body := get(t, client, server.URL); status != http.StatusOK || body != `{"releases":[21]}` {
+		t.Fatalf("Unexpected revalidated response: %d %s", status, body)
+	}
+	if requests != 2 || notModified != 1 {
+		t.Errorf("Expected a conditional request, got %d requests and %d not modified", requests, notModified)
+	}
+}
+
+func TestTransport_Refresh(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	get(t, &http.Client{Transport: New(dir, time.Hour, ModeDefault, nil)}, server.URL)
+	get(t, &http.Client{Transport: New(dir, time.Hour, ModeRefresh, nil)}, server.URL)
+
+	if requests != 2 {
+		t.Errorf("Expected refresh mode to ignore the TTL, got %d requests", requests)
+	}
+}
+
+func TestTransport_Offline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("cached"))
+	}))
+	url := server.URL
+
+	dir := t.TempDir()
+	online := &http.Client{Transport: New(dir, 0, ModeDefault, nil)}
+	get(t, online, url)
+	get(t, online, url+"/missing")
+	server.Close()
+
+	// Stale entries are served when the server cannot be reached
+	if status, body := get(t, online, url); status != http.StatusOK || body != "cached" {
+		t.Errorf("Expected stale entry as fallback, got %d %s", status, body)
+	}
+
+	offline := &http.Client{Transport: New(dir, 0, ModeOffline, nil)}
+	if status, body := get(t, offline, url); status != http.StatusOK || body != "cached" {
+		t.Errorf("Expected cached entry offline, got %d %s", status, body)
+	}
+	if status, _ := get(t, offline, url+"/missing"); status != http.StatusNotFound {
+		t.Errorf("Expected cached not found response offline, got %d", status)
+	}
+
+	_, err := offline.Get(url + "/other")
+	if !errors.Is(err, ErrNotCached) {
+		t.Errorf("Expected ErrNotCached for an uncached URL, got %v", err)
+	}
+}
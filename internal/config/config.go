@@ -0,0 +1,195 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// Environment variables that override values from the config file
+const (
+	EnvConfigFile      = "JDK_MANAGER_CONFIG"
+	EnvAdoptiumAPI     = "JDK_MANAGER_ADOPTIUM_API"
+	EnvDownloadHost    = "JDK_MANAGER_DOWNLOAD_HOST"
+	EnvMirrors         = "JDK_MANAGER_MIRRORS"
+	EnvSignatureKeys   = "JDK_MANAGER_SIGNATURE_KEYS"
+	EnvSignatureKeyURL = "JDK_MANAGER_SIGNATURE_KEY_URL"
+	EnvCacheTTL        = "JDK_MANAGER_CACHE_TTL"
+	EnvHTTPRetries     = "JDK_MANAGER_HTTP_RETRIES"
+	EnvHTTPTimeout     = "JDK_MANAGER_HTTP_TIMEOUT"
+	EnvHTTPBackoff     = "JDK_MANAGER_HTTP_BACKOFF"
+	EnvProxy           = "JDK_MANAGER_PROXY"
+	EnvNoProxy         = "JDK_MANAGER_NO_PROXY"
+	EnvProxyUsername   = "JDK_MANAGER_PROXY_USERNAME"
+	EnvProxyPassword   = "JDK_MANAGER_PROXY_PASSWORD"
+	EnvCABundle        = "JDK_MANAGER_CA_BUNDLE"
+	EnvLockTimeout     = "JDK_MANAGER_LOCK_TIMEOUT"
+)
+
+// Config holds user settings read from ~/.jdks/config.json.
+// Empty values mean "use the built-in default".
+type Config struct {
+	// AdoptiumAPI is the base URL of the Adoptium API, e.g. https://api.adoptium.net/v3
+	AdoptiumAPI string `json:"adoptium_api,omitempty"`
+	// DownloadHost replaces the scheme and host of Adoptium package links,
+	// e.g. https://artifactory.example.com/github
+	DownloadHost string `json:"download_host,omitempty"`
+	// Mirrors are tried in order before the upstream download URL. A mirror is either
+	// a base URL the upstream path is appended to, or a template using {host}, {path}
+	// and {filename}.
+	Mirrors []string `json:"mirrors,omitempty"`
+	// SignatureKeys are paths to additional OpenPGP public keys trusted for
+	// archive signatures, next to the pinned Adoptium key
+	SignatureKeys []string `json:"signature_keys,omitempty"`
+	// SignatureKeyURL is where the pinned Adoptium key is fetched from when it is
+	// not cached yet, e.g. an internal keyserver. Defaults to keyserver.ubuntu.com.
+	SignatureKeyURL string `json:"signature_key_url,omitempty"`
+	// CacheTTL is how long release metadata is used before it is revalidated with
+	// the server, as a Go duration such as 6h or 30m. "0" always revalidates.
+	CacheTTL string `json:"cache_ttl,omitempty"`
+	// HTTPRetries is how often failed requests are retried. Nil uses the default of 3.
+	HTTPRetries *int `json:"http_retries,omitempty"`
+	// HTTPTimeout is how long a connection may stall before it is aborted, e.g. 60s.
+	// Downloads are not limited in total duration.
+	HTTPTimeout string `json:"http_timeout,omitempty"`
+	// HTTPBackoff is the delay before the first retry, doubled for every further one, e.g. 1s
+	HTTPBackoff string `json:"http_backoff,omitempty"`
+	// Proxy is the URL of the proxy used for all requests, e.g. http://proxy.example.com:3128.
+	// It takes precedence over the HTTP_PROXY and HTTPS_PROXY environment variables.
+	Proxy string `json:"proxy,omitempty"`
+	// NoProxy lists hosts reached without the proxy: host names, domain suffixes
+	// such as .example.com, IP addresses and CIDR ranges
+	NoProxy []string `json:"no_proxy,omitempty"`
+	// ProxyUsername and ProxyPassword are sent to the proxy as basic authentication
+	ProxyUsername string `json:"proxy_username,omitempty"`
+	ProxyPassword string `json:"proxy_password,omitempty"`
+	// CABundle is a PEM file with extra trusted certificates, e.g. the root of a
+	// TLS-inspecting proxy. The system trust store is still used.
+	CABundle string `json:"ca_bundle,omitempty"`
+	// LockTimeout is how long install and uninstall wait for another jdk process
+	// working on the same version, e.g. 10m. "0" fails right away.
+	LockTimeout string `json:"lock_timeout,omitempty"`
+}
+
+// Path returns the location of the config file
+func Path() (string, error) {
+	if path := os.Getenv(EnvConfigFile); path != "" {
+		return path, nil
+	}
+
+	homeDir, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	return filepath.Join(homeDir, ".jdks", "config.json"), nil
+}
+
+// Load reads the config file and applies environment overrides.
+// A missing config file is not an error.
+func Load() (*Config, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.ApplyEnv(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// LoadFile reads a config file without applying environment overrides
+func LoadFile(path string) (*Config, error) {
+	cfg := &Config{}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// ApplyEnv overrides settings with the JDK_MANAGER_* environment variables
+func (c *Config) ApplyEnv() error {
+	if value := os.Getenv(EnvAdoptiumAPI); value != "" {
+		c.AdoptiumAPI = value
+	}
+	if value := os.Getenv(EnvDownloadHost); value != "" {
+		c.DownloadHost = value
+	}
+	if value := os.Getenv(EnvMirrors); value != "" {
+		c.Mirrors = splitList(value)
+	}
+	if value := os.Getenv(EnvSignatureKeys); value != "" {
+		c.SignatureKeys = splitList(value)
+	}
+	if value := os.Getenv(EnvSignatureKeyURL); value != "" {
+		c.SignatureKeyURL = value
+	}
+	if value := os.Getenv(EnvCacheTTL); value != "" {
+		c.CacheTTL = value
+	}
+	if value := os.Getenv(EnvHTTPRetries); value != "" {
+		retries, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", EnvHTTPRetries, err)
+		}
+		c.HTTPRetries = &retries
+	}
+	if value := os.Getenv(EnvHTTPTimeout); value != "" {
+		c.HTTPTimeout = value
+	}
+	if value := os.Getenv(EnvHTTPBackoff); value != "" {
+		c.HTTPBackoff = value
+	}
+	if value := os.Getenv(EnvProxy); value != "" {
+		c.Proxy = value
+	}
+	if value := os.Getenv(EnvNoProxy); value != "" {
+		c.NoProxy = splitList(value)
+	}
+	if value := os.Getenv(EnvProxyUsername); value != "" {
+		c.ProxyUsername = value
+	}
+	if value := os.Getenv(EnvProxyPassword); value != "" {
+		c.ProxyPassword = value
+	}
+	if value := os.Getenv(EnvCABundle); value != "" {
+		c.CABundle = value
+	}
+	if value := os.Getenv(EnvLockTimeout); value != "" {
+		c.LockTimeout = value
+	}
+
+	return nil
+}
+
+// splitList splits a comma separated list, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadFile_Missing(t *testing.T) {
+	cfg, err := LoadFile(filepath.Join(t.TempDir(), "config.json"))
+	if err != nil {
+		t.Fatalf("Missing config file should not be an error: %v", err)
+	}
+
+	if cfg.AdoptiumAPI != "" || len(cfg.Mirrors) != 0 {
+		t.Fatalf("Expected empty config, got %+v", cfg)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	content := `{"adoptium_api": "https://adoptium.internal/v3", "mirrors": ["https://m1", "https://m2"]}`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	cfg, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	if cfg.AdoptiumAPI != "https://adoptium.internal/v3" {
+		t.Errorf("Unexpected adoptium_api: %s", cfg.AdoptiumAPI)
+	}
+	if len(cfg.Mirrors) != 2 || cfg.Mirrors[1] != "https://m2" {
+		t.Errorf("Unexpected mirrors: %v", cfg.Mirrors)
+	}
+
+	if err := os.WriteFile(path, []byte("{invalid"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if _, err := LoadFile(path); err == nil {
+		t.Fatal("Expected error for invalid config file")
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	t.Setenv(EnvAdoptiumAPI, "https://env.example.com/v3")
+	t.Setenv(EnvMirrors, "https://a, ,https://b")
+	t.Setenv(EnvCacheTTL, "1h")
+	t.Setenv(EnvHTTPRetries, "0")
+
+	cfg := &Config{AdoptiumAPI: "https://file.example.com/v3", DownloadHost: "https://host"}
+	if err := cfg.ApplyEnv(); err != nil {
+		t.Fatalf("Failed to apply environment: %v", err)
+	}
+
+	if cfg.AdoptiumAPI != "https://env.example.com/v3" {
+		t.Errorf("Environment should override the config file, got %s", cfg.AdoptiumAPI)
+	}
+	if cfg.DownloadHost != "https://host" {
+		t.Errorf("Unset environment variable should keep the file value, got %s", cfg.DownloadHost)
+	}
+	if len(cfg.Mirrors) != 2 || cfg.Mirrors[0] != "https://a" || cfg.Mirrors[1] != "https://b" {
+		t.Errorf("Unexpected mirrors: %v", cfg.Mirrors)
+	}
+	if cfg.CacheTTL != "1h" {
+		t.Errorf("Unexpected cache TTL: %s", cfg.CacheTTL)
+	}
+	if cfg.HTTPRetries == nil || *cfg.HTTPRetries != 0 {
+		t.Errorf("Expected retries to be disabled, got %v", cfg.HTTPRetries)
+	}
+
+	t.Setenv(EnvHTTPRetries, "many")
+	if err := cfg.ApplyEnv(); err == nil {
+		t.Error("Expected error for a non-numeric retry count")
+	}
+}
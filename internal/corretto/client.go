@@ -0,0 +1,213 @@
+package corretto
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/httpclient"
+	"github.com/jdk-manager/internal/version"
+)
+
+const (
+	correttoIndexURL     = "https://corretto.github.io/corretto-downloads/latest_links/indexmap_with_checksum.json"
+	correttoDownloadBase = "https://corretto.aws"
+)
+
+// Client handles communication with the Amazon Corretto download index
+type Client struct {
+	httpClient   *http.Client
+	indexURL     string
+	downloadBase string
+}
+
+// indexEntry describes one downloadable artifact in the Corretto index
+type indexEntry struct {
+	Resource       string `json:"resource"`
+	Checksum       string `json:"checksum"`
+	ChecksumSHA256 string `json:"checksum_sha256"`
+}
+
+// index maps os -> arch -> image type -> major version -> archive type -> entry
+type index map[string]map[string]map[string]map[string]map[string]indexEntry
+
+// NewClient creates a new Corretto client
+func NewClient() *Client {
+	return &Client{
+		httpClient:   httpclient.New(),
+		indexURL:     correttoIndexURL,
+		downloadBase: correttoDownloadBase,
+	}
+}
+
+// SetHTTPClient replaces the client used for API requests, e.g. with a caching one
+func (c *Client) SetHTTPClient(client *http.Client) {
+	c.httpClient = client
+}
+
+// Vendor returns the distribution served by this client
+func (c *Client) Vendor() adoptium.Vendor {
+	return adoptium.Vendor{
+		Name:        "corretto",
+		DisplayName: "Amazon Corretto",
+		Aliases:     []string{"amazon"},
+		Homepage:    "https://aws.amazon.com/corretto",
+	}
+}
+
+// GetAvailableReleases returns the latest Corretto release of every major version
+// published for the current platform
+func (c *Client) GetAvailableReleases(ctx context.Context) ([]adoptium.Release, error) {
+	entries, err := c.platformEntries(ctx, adoptium.CurrentPlatform(), adoptium.ImageTypeJDK)
+	if err != nil {
+		return nil, err
+	}
+
+	var releases []adoptium.Release
+	for _, entry := range entries {
+		versionData, err := parseResourceVersion(entry.Resource)
+		if err != nil {
+			continue
+		}
+		releases = append(releases, adoptium.Release{
+			VersionData: versionData,
+		})
+	}
+
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].VersionData.Major > releases[j].VersionData.Major
+	})
+
+	return releases, nil
+}
+
+// GetDownloadInfo gets download information for a specific Corretto version.
+// Only the latest build of each major version is published in the index.
+func (c *Client) GetDownloadInfo(ctx context.Context, request adoptium.DownloadRequest) (*adoptium.DownloadInfo, error) {
+	requested, err := version.Parse(request.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version format: %s", request.Version)
+	}
+	major := requested.Major
+
+	// The index only lists JDK and JRE archives
+	imageType := request.ImageTypeOrDefault()
+	if imageType != adoptium.ImageTypeJDK && imageType != adoptium.ImageTypeJRE {
+		return nil, fmt.Errorf("Amazon Corretto does not publish %s images", imageType)
+	}
+
+	platform := request.Platform()
+	entries, err := c.platformEntries(ctx, platform, imageType)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, ok := entries[major]
+	if !ok {
+		return nil, fmt.Errorf("no Corretto %d %s build found for %s/%s", major, imageType, c.getOSName(platform), platform.VendorArch())
+	}
+
+	versionData, err := parseResourceVersion(entry.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if !versionData.Matches(request.Version) {
+		return nil, fmt.Errorf("Corretto %s is not available, latest %d release is %s",
+			request.Version, major, formatVersion(versionData))
+	}
+
+	return &adoptium.DownloadInfo{
+		URL:       c.downloadBase + entry.Resource,
+		Filename:  path.Base(entry.Resource),
+		Vendor:    c.Vendor().Name,
+		Version:   versionData,
+		Checksum:  entry.ChecksumSHA256,
+		ImageType: imageType,
+		Platform:  platform,
+	}, nil
+}
+
+// platformEntries fetches the index and returns the archives of an image type (jdk or jre)
+// for a platform, keyed by major version
+func (c *Client) platformEntries(ctx context.Context, platform adoptium.Platform, imageType string) (map[int]indexEntry, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.indexURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch Corretto index: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Corretto index request failed with status: %d", resp.StatusCode)
+	}
+
+	var idx index
+	if err := json.NewDecoder(resp.Body).Decode(&idx); err != nil {
+		return nil, fmt.Errorf("failed to decode Corretto index: %w", err)
+	}
+
+	archiveType := platform.ArchiveType()
+	entries := make(map[int]indexEntry)
+	for majorStr, archives := range idx[c.getOSName(platform)][platform.VendorArch()][imageType] {
+		major, err := strconv.Atoi(majorStr)
+		if err != nil {
+			continue
+		}
+		if entry, ok := archives[archiveType]; ok {
+			entries[major] = entry
+		}
+	}
+
+	return entries, nil
+}
+
+// getOSName returns the OS name of a platform in Corretto index format.
+// musl builds are listed under "alpine".
+func (c *Client) getOSName(platform adoptium.Platform) string {
+	if platform.OS == "linux" && platform.LibC == adoptium.LibCMusl {
+		return "alpine"
+	}
+	return platform.VendorOS()
+}
+
+// parseResourceVersion extracts the version from a resource path such as
+// /downloads/resources/21.0.2.13.1/amazon-corretto-21.0.2.13.1-linux-x64.tar.gz
+// Corretto 8 uses the 8.<update>.<build>.<revision> scheme.
+func parseResourceVersion(resource string) (adoptium.VersionData, error) {
+	name := strings.TrimPrefix(path.Base(resource), "amazon-corretto-")
+	versionStr := strings.Split(name, "-")[0]
+
+	var nums []int
+	for _, part := range strings.Split(versionStr, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return adoptium.VersionData{}, fmt.Errorf("unexpected Corretto version: %s", versionStr)
+		}
+		nums = append(nums, n)
+	}
+
+	switch {
+	case len(nums) >= 3 && nums[0] == 8:
+		return adoptium.VersionData{Major: 8, Security: nums[1], Build: nums[2]}, nil
+	case len(nums) >= 4:
+		return adoptium.VersionData{Major: nums[0], Minor: nums[1], Security: nums[2], Build: nums[3]}, nil
+	default:
+		return adoptium.VersionData{}, fmt.Errorf("unexpected Corretto version: %s", versionStr)
+	}
+}
+
+// formatVersion renders version data as major.minor.security
+func formatVersion(v adoptium.VersionData) string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Security)
+}
@@ -0,0 +1,141 @@
+package corretto
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jdk-manager/internal/adoptium"
+)
+
+func TestGetAvailableReleases(t *testing.T) {
+	client := NewClient()
+	platform := adoptium.CurrentPlatform()
+	archiveType := platform.ArchiveType()
+
+	// JRE-only major versions are not listed as releases
+	indexJSON := fmt.Sprintf(`{
+		%q: {
+			%q: {
+				"jdk": {
+					"21": {%q: {"resource": "/downloads/resources/21.0.2.13.1/amazon-corretto-21.0.2.13.1-test.%s"}},
+					"8": {%q: {"resource": "/downloads/resources/8.402.08.1/amazon-corretto-8.402.08.1-test.%s"}}
+				},
+				"jre": {
+					"17": {%q: {"resource": "/downloads/resources/17.0.10.7.1/amazon-corretto-17.0.10.7.1-test.%s"}}
+				}
+			}
+		}
+	}`, client.getOSName(platform), platform.VendorArch(), archiveType, archiveType, archiveType, archiveType, archiveType, archiveType)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, indexJSON)
+	}))
+	defer server.Close()
+	client.indexURL = server.URL
+
+	releases, err := client.GetAvailableReleases(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get releases: %v", err)
+	}
+
+	if len(releases) != 2 {
+		t.Fatalf("Expected 2 releases, got %d", len(releases))
+	}
+
+	if releases[0].VersionData.Major != 21 || releases[0].VersionData.Security != 2 || releases[0].VersionData.Build != 13 {
+		t.Errorf("Unexpected first release: %+v", releases[0].VersionData)
+	}
+
+	if releases[1].VersionData.Major != 8 || releases[1].VersionData.Security != 402 {
+		t.Errorf("Unexpected second release: %+v", releases[1].VersionData)
+	}
+}
+
+func TestGetDownloadInfo(t *testing.T) {
+	client := NewClient()
+	platform := adoptium.CurrentPlatform()
+	archiveType := platform.ArchiveType()
+
+	indexJSON := fmt.Sprintf(`{
+		%q: {
+			%q: {
+				"jdk": {
+					"21": {%q: {"resource": "/downloads/resources/21.0.2.13.1/amazon-corretto-21.0.2.13.1-test.%s", "checksum_sha256": "abc123"}}
+				},
+				"jre": {
+					"17": {%q: {"resource": "/downloads/resources/17.0.10.7.1/amazon-corretto-17.0.10.7.1-test.%s"}}
+				}
+			}
+		}
+	}`, client.getOSName(platform), platform.VendorArch(), archiveType, archiveType, archiveType, archiveType)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, indexJSON)
+	}))
+	defer server.Close()
+	client.indexURL = server.URL
+	client.downloadBase = "https://downloads.example.com"
+
+	info, err := client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "21"})
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+
+	expectedURL := "https://downloads.example.com/downloads/resources/21.0.2.13.1/amazon-corretto-21.0.2.13.1-test." + platform.ArchiveType()
+	if info.URL != expectedURL {
+		t.Errorf("Expected URL %s, got %s", expectedURL, info.URL)
+	}
+
+	if info.Filename != "amazon-corretto-21.0.2.13.1-test."+platform.ArchiveType() {
+		t.Errorf("Unexpected filename %s", info.Filename)
+	}
+
+	if info.Checksum != "abc123" {
+		t.Errorf("Expected SHA-256 from the index, got %q", info.Checksum)
+	}
+
+	if _, err := client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "21.0.2"}); err != nil {
+		t.Errorf("Expected exact latest version to resolve: %v", err)
+	}
+
+	if _, err := client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "21.0.1"}); err == nil {
+		t.Error("Expected error for a version that is not the latest build")
+	}
+
+	if _, err := client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "17"}); err == nil {
+		t.Error("Expected error for a major version without a JDK build")
+	}
+}
+
+func TestParseResourceVersion(t *testing.T) {
+	tests := []struct {
+		resource               string
+		major, security, build int
+		hasError               bool
+	}{
+		{"/downloads/resources/21.0.2.13.1/amazon-corretto-21.0.2.13.1-linux-x64.tar.gz", 21, 2, 13, false},
+		{"/downloads/resources/11.0.22.7.1/amazon-corretto-11.0.22.7.1-windows-x64-jdk.zip", 11, 22, 7, false},
+		{"/downloads/resources/8.402.08.1/amazon-corretto-8.402.08.1-macosx-aarch64.tar.gz", 8, 402, 8, false},
+		{"/downloads/resources/latest/amazon-corretto-latest.tar.gz", 0, 0, 0, true},
+	}
+
+	for _, test := range tests {
+		v, err := parseResourceVersion(test.resource)
+		if test.hasError {
+			if err == nil {
+				t.Errorf("Expected error for resource %s", test.resource)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for resource %s: %v", test.resource, err)
+			continue
+		}
+		if v.Major != test.major || v.Security != test.security || v.Build != test.build {
+			t.Errorf("Unexpected version for %s: %+v", test.resource, v)
+		}
+	}
+}
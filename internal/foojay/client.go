@@ -0,0 +1,322 @@
+package foojay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/httpclient"
+	"github.com/jdk-manager/internal/version"
+)
+
+const (
+	discoAPIBase = "https://api.foojay.io/disco/v3.0"
+)
+
+// Distribution describes a vendor served through the Disco API
+type Distribution struct {
+	Name        string // Distribution identifier in the Disco API, e.g. "sap_machine"
+	DisplayName string
+	Aliases     []string
+	Homepage    string
+}
+
+// Distributions lists the vendors installed through the Disco API
+var Distributions = []Distribution{
+	{Name: "sap_machine", DisplayName: "SapMachine", Aliases: []string{"sapmachine", "sap"}, Homepage: "https://sap.github.io/SapMachine"},
+	{Name: "liberica", DisplayName: "BellSoft Liberica", Aliases: []string{"bellsoft"}, Homepage: "https://bell-sw.com/libericajdk"},
+	{Name: "microsoft", DisplayName: "Microsoft Build of OpenJDK", Aliases: []string{"ms"}, Homepage: "https://www.microsoft.com/openjdk"},
+	{Name: "dragonwell", DisplayName: "Alibaba Dragonwell", Aliases: []string{"alibaba"}, Homepage: "https://dragonwell-jdk.io"},
+}
+
+// Client handles communication with the foojay Disco API for a single distribution
+type Client struct {
+	httpClient   *http.Client
+	baseURL      string
+	distribution Distribution
+}
+
+// Package represents a package returned by the Disco API
+type Package struct {
+	ID                  string `json:"id"`
+	ArchiveType         string `json:"archive_type"`
+	Distribution        string `json:"distribution"`
+	MajorVersion        int    `json:"major_version"`
+	JavaVersion         string `json:"java_version"`
+	DistributionVersion string `json:"distribution_version"`
+	ReleaseStatus       string `json:"release_status"`
+	TermOfSupport       string `json:"term_of_support"`
+	OperatingSystem     string `json:"operating_system"`
+	Architecture        string `json:"architecture"`
+	PackageType         string `json:"package_type"`
+	Filename            string `json:"filename"`
+	Size                int64  `json:"size"`
+	Links               Links  `json:"links"`
+}
+
+// Links contains the URLs of a Disco API package
+type Links struct {
+	PkgInfoURI          string `json:"pkg_info_uri"`
+	PkgDownloadRedirect string `json:"pkg_download_redirect"`
+}
+
+// NewClient creates a new Disco API client for the given distribution
+func NewClient(distribution Distribution) *Client {
+	return &Client{
+		httpClient:   httpclient.New(),
+		baseURL:      discoAPIBase,
+		distribution: distribution,
+	}
+}
+
+// SetHTTPClient replaces the client used for API requests, e.g. with a caching one
+func (c *Client) SetHTTPClient(client *http.Client) {
+	c.httpClient = client
+}
+
+// Vendor returns the distribution served by this client
+func (c *Client) Vendor() adoptium.Vendor {
+	return adoptium.Vendor{
+		Name:        c.distribution.Name,
+		DisplayName: c.distribution.DisplayName,
+		Aliases:     c.distribution.Aliases,
+		Homepage:    c.distribution.Homepage,
+	}
+}
+
+// GetAvailableReleases returns the latest release of every major version
+// published for the current platform
+func (c *Client) GetAvailableReleases(ctx context.Context) ([]adoptium.Release, error) {
+	packages, err := c.searchPackages(ctx, "", adoptium.ImageTypeJDK, adoptium.CurrentPlatform())
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the newest package of each major version
+	latest := make(map[int]adoptium.VersionData)
+	for _, pkg := range packages {
+		versionData, err := parseJavaVersion(pkg.JavaVersion)
+		if err != nil {
+			continue
+		}
+		if current, exists := latest[versionData.Major]; !exists || versionData.Compare(current) > 0 {
+			latest[versionData.Major] = versionData
+		}
+	}
+
+	var releases []adoptium.Release
+	for _, versionData := range latest {
+		releases = append(releases, adoptium.Release{VersionData: versionData})
+	}
+
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].VersionData.Major > releases[j].VersionData.Major
+	})
+
+	return releases, nil
+}
+
+// GetDownloadInfo gets download information for a specific version
+func (c *Client) GetDownloadInfo(ctx context.Context, request adoptium.DownloadRequest) (*adoptium.DownloadInfo, error) {
+	requested, err := version.Parse(request.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version format: %s", request.Version)
+	}
+
+	// The Disco API only distinguishes JDK and JRE packages
+	imageType := request.ImageTypeOrDefault()
+	if imageType != adoptium.ImageTypeJDK && imageType != adoptium.ImageTypeJRE {
+		return nil, fmt.Errorf("%s does not publish %s images", c.distribution.DisplayName, imageType)
+	}
+
+	platform := request.Platform()
+	packages, err := c.searchPackages(ctx, requested.Number(), imageType, platform)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pick the newest package matching the requested version
+	var best *Package
+	var bestVersion adoptium.VersionData
+	for i := range packages {
+		versionData, err := parseJavaVersion(packages[i].JavaVersion)
+		if err != nil || !versionData.Matches(request.Version) {
+			continue
+		}
+		if best == nil || versionData.Compare(bestVersion) > 0 {
+			best = &packages[i]
+			bestVersion = versionData
+		}
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("no suitable %s %s found for version %s on %s/%s",
+			c.distribution.DisplayName, imageType, request.Version, platform.VendorOS(), platform.VendorArch())
+	}
+
+	checksum, err := c.getChecksum(ctx, best)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adoptium.DownloadInfo{
+		URL:       best.Links.PkgDownloadRedirect,
+		Filename:  best.Filename,
+		Size:      best.Size,
+		Vendor:    c.Vendor().Name,
+		Version:   bestVersion,
+		Checksum:  checksum,
+		ImageType: imageType,
+		Platform:  platform,
+	}, nil
+}
+
+// getChecksum fetches the package info of a package and returns its SHA-256 checksum,
+// or an empty string if the vendor does not publish one
+func (c *Client) getChecksum(ctx context.Context, pkg *Package) (string, error) {
+	if pkg.Links.PkgInfoURI == "" {
+		return "", nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pkg.Links.PkgInfoURI, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch package info: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+	}
+
+	var apiResponse struct {
+		Result []struct {
+			Checksum     string `json:"checksum"`
+			ChecksumType string `json:"checksum_type"`
+		} `json:"result"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return "", fmt.Errorf("failed to decode API response: %w", err)
+	}
+
+	for _, info := range apiResponse.Result {
+		if strings.EqualFold(info.ChecksumType, "sha256") && info.Checksum != "" {
+			return info.Checksum, nil
+		}
+	}
+
+	return "", nil
+}
+
+// GetLTSReleases returns the major versions the Disco API marks as long-term support
+func (c *Client) GetLTSReleases(ctx context.Context) ([]int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/major_versions", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch major versions: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+	}
+
+	var apiResponse struct {
+		Result []struct {
+			MajorVersion  int    `json:"major_version"`
+			TermOfSupport string `json:"term_of_support"`
+		} `json:"result"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode API response: %w", err)
+	}
+
+	var ltsReleases []int
+	for _, majorVersion := range apiResponse.Result {
+		if strings.EqualFold(majorVersion.TermOfSupport, "lts") {
+			ltsReleases = append(ltsReleases, majorVersion.MajorVersion)
+		}
+	}
+
+	if len(ltsReleases) == 0 {
+		return nil, fmt.Errorf("no LTS releases listed by %s", c.baseURL)
+	}
+
+	return ltsReleases, nil
+}
+
+// searchPackages queries the Disco API for GA archives of a package type (jdk or jre)
+// for a platform. An empty version returns the latest package of every major version.
+func (c *Client) searchPackages(ctx context.Context, version, packageType string, platform adoptium.Platform) ([]Package, error) {
+	query := url.Values{}
+	query.Set("distribution", c.distribution.Name)
+	query.Set("operating_system", platform.VendorOS())
+	query.Set("architecture", platform.VendorArch())
+	query.Add("archive_type", "tar.gz")
+	query.Add("archive_type", "zip")
+	query.Set("package_type", packageType)
+	query.Set("release_status", "ga")
+	query.Set("javafx_bundled", "false")
+	if platform.LibC != "" {
+		query.Set("lib_c_type", platform.LibC)
+	}
+	if version != "" {
+		query.Set("version", version)
+		query.Set("latest", "per_version")
+	} else {
+		query.Set("latest", "available")
+	}
+
+	apiURL := fmt.Sprintf("%s/packages?%s", c.baseURL, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s packages: %w", c.distribution.DisplayName, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+	}
+
+	var apiResponse struct {
+		Result  []Package `json:"result"`
+		Message string    `json:"message"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode API response: %w", err)
+	}
+
+	return apiResponse.Result, nil
+}
+
+// parseJavaVersion parses Disco API versions such as 21.0.2, 21.0.2+13 or 8.0.402+7
+func parseJavaVersion(javaVersion string) (adoptium.VersionData, error) {
+	v, err := version.Parse(javaVersion)
+	if err != nil {
+		return adoptium.VersionData{}, fmt.Errorf("invalid java version: %s", javaVersion)
+	}
+
+	return adoptium.NewVersionData(v), nil
+}
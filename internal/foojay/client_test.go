@@ -0,0 +1,171 @@
+package foojay
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jdk-manager/internal/adoptium"
+)
+
+func TestVendor(t *testing.T) {
+	client := NewClient(Distributions[0])
+	if client.Vendor().Name != "sap_machine" {
+		t.Fatalf("Expected vendor sap_machine, got %s", client.Vendor().Name)
+	}
+}
+
+func TestGetAvailableReleases(t *testing.T) {
+	packages := []Package{
+		{JavaVersion: "21.0.2+13", Filename: "sapmachine-jdk-21.0.2_linux-x64_bin.tar.gz"},
+		{JavaVersion: "21.0.1", Filename: "sapmachine-jdk-21.0.1_linux-x64_bin.tar.gz"},
+		{JavaVersion: "17.0.10+7", Filename: "sapmachine-jdk-17.0.10_linux-x64_bin.tar.gz"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/packages" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Query().Get("distribution") != "sap_machine" {
+			t.Errorf("Unexpected distribution in query: %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"result": packages, "message": ""})
+	}))
+	defer server.Close()
+
+	client := NewClient(Distributions[0])
+	client.baseURL = server.URL
+
+	releases, err := client.GetAvailableReleases(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get releases: %v", err)
+	}
+
+	if len(releases) != 2 {
+		t.Fatalf("Expected 2 releases, got %d", len(releases))
+	}
+
+	if v := releases[0].VersionData; v.Major != 21 || v.Security != 2 || v.Build != 13 {
+		t.Errorf("Expected 21.0.2+13, got %+v", v)
+	}
+
+	if v := releases[1].VersionData; v.Major != 17 || v.Security != 10 {
+		t.Errorf("Expected 17.0.10, got %+v", v)
+	}
+}
+
+func TestGetDownloadInfo(t *testing.T) {
+	var packages []Package
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ids/new" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"result": []map[string]string{{"checksum": "abc123", "checksum_type": "sha256"}},
+			})
+			return
+		}
+		if r.URL.Path != "/packages" {
+			http.NotFound(w, r)
+			return
+		}
+		queries = append(queries, r.URL.Query().Get("version"))
+		json.NewEncoder(w).Encode(map[string]interface{}{"result": packages, "message": ""})
+	}))
+	defer server.Close()
+
+	// The checksum is read from the package info link of the chosen package
+	packages = []Package{
+		{JavaVersion: "21.0.1+12", Filename: "old.tar.gz", Links: Links{PkgDownloadRedirect: "https://example.com/old"}},
+		{JavaVersion: "21.0.2+13", Filename: "new.tar.gz", Size: 42, Links: Links{PkgDownloadRedirect: "https://example.com/new", PkgInfoURI: server.URL + "/ids/new"}},
+	}
+
+	client := NewClient(Distributions[0])
+	client.baseURL = server.URL
+
+	info, err := client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "21"})
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+	if info.URL != "https://example.com/new" || info.Filename != "new.tar.gz" || info.Size != 42 {
+		t.Errorf("Expected newest package, got %+v", info)
+	}
+	if info.Checksum != "abc123" {
+		t.Errorf("Expected checksum from package info, got %q", info.Checksum)
+	}
+
+	info, err = client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "21.0.1"})
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+	if info.Filename != "old.tar.gz" {
+		t.Errorf("Expected 21.0.1 package, got %+v", info)
+	}
+
+	if len(queries) == 0 || queries[0] != "21" {
+		t.Errorf("Expected version to be passed to the API, got %v", queries)
+	}
+
+	if _, err := client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "21.0.3"}); err == nil {
+		t.Error("Expected error for unavailable version")
+	}
+}
+
+func TestParseJavaVersion(t *testing.T) {
+	tests := []struct {
+		javaVersion            string
+		major, security, build int
+		hasError               bool
+	}{
+		{"21.0.2", 21, 2, 0, false},
+		{"21.0.2+13", 21, 2, 13, false},
+		{"8.0.402+7", 8, 402, 7, false},
+		{"17.0.10.1+1", 17, 10, 1, false},
+		{"invalid", 0, 0, 0, true},
+	}
+
+	for _, test := range tests {
+		v, err := parseJavaVersion(test.javaVersion)
+		if test.hasError {
+			if err == nil {
+				t.Errorf("Expected error for %s", test.javaVersion)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %v", test.javaVersion, err)
+			continue
+		}
+		if v.Major != test.major || v.Security != test.security || v.Build != test.build {
+			t.Errorf("parseJavaVersion(%s) = %+v", test.javaVersion, v)
+		}
+	}
+}
+
+func TestGetLTSReleases(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/major_versions" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"result": [
+			{"major_version": 25, "term_of_support": "LTS"},
+			{"major_version": 24, "term_of_support": "STS"},
+			{"major_version": 21, "term_of_support": "LTS"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Distributions[0])
+	client.baseURL = server.URL
+
+	releases, err := client.GetLTSReleases(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get LTS releases: %v", err)
+	}
+	if len(releases) != 2 || releases[0] != 25 || releases[1] != 21 {
+		t.Errorf("Unexpected LTS releases: %v", releases)
+	}
+}
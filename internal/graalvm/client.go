@@ -0,0 +1,210 @@
+package graalvm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/httpclient"
+	"github.com/jdk-manager/internal/version"
+)
+
+const (
+	githubAPIBase = "https://api.github.com"
+	releasesRepo  = "graalvm/graalvm-ce-builds"
+)
+
+// Client handles communication with the GraalVM CE GitHub releases
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// githubRelease represents a release from the GitHub releases API
+type githubRelease struct {
+	TagName    string        `json:"tag_name"`
+	PreRelease bool          `json:"prerelease"`
+	Draft      bool          `json:"draft"`
+	Assets     []githubAsset `json:"assets"`
+}
+
+// githubAsset represents a file attached to a GitHub release
+type githubAsset struct {
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+	Size               int64  `json:"size"`
+}
+
+// NewClient creates a new GraalVM CE client
+func NewClient() *Client {
+	return &Client{
+		httpClient: httpclient.New(),
+		baseURL:    githubAPIBase,
+	}
+}
+
+// SetHTTPClient replaces the client used for API requests, e.g. with a caching one
+func (c *Client) SetHTTPClient(client *http.Client) {
+	c.httpClient = client
+}
+
+// Vendor returns the distribution served by this client
+func (c *Client) Vendor() adoptium.Vendor {
+	return adoptium.Vendor{
+		Name:        "graalvm",
+		DisplayName: "GraalVM Community",
+		Aliases:     []string{"graalvm_ce", "graalvm_community"},
+		Homepage:    "https://www.graalvm.org",
+	}
+}
+
+// GetAvailableReleases returns the GraalVM CE releases that ship a build for the current platform
+func (c *Client) GetAvailableReleases(ctx context.Context) ([]adoptium.Release, error) {
+	ghReleases, err := c.fetchReleases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var releases []adoptium.Release
+	for _, ghRelease := range ghReleases {
+		versionData, ok := parseTag(ghRelease.TagName)
+		if !ok || ghRelease.Draft || ghRelease.PreRelease {
+			continue
+		}
+		if _, ok := c.findAsset(ghRelease, adoptium.CurrentPlatform()); !ok {
+			continue
+		}
+		releases = append(releases, adoptium.Release{VersionData: versionData})
+	}
+
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].VersionData.Compare(releases[j].VersionData) > 0
+	})
+
+	return releases, nil
+}
+
+// GetDownloadInfo gets download information for a specific GraalVM CE version
+func (c *Client) GetDownloadInfo(ctx context.Context, request adoptium.DownloadRequest) (*adoptium.DownloadInfo, error) {
+	if _, err := version.Parse(request.Version); err != nil {
+		return nil, fmt.Errorf("invalid version format: %s", request.Version)
+	}
+
+	// GraalVM CE is only published as a full JDK built against glibc
+	if imageType := request.ImageTypeOrDefault(); imageType != adoptium.ImageTypeJDK {
+		return nil, fmt.Errorf("GraalVM CE does not publish %s images", imageType)
+	}
+	platform := request.Platform()
+	if platform.LibC == adoptium.LibCMusl {
+		return nil, fmt.Errorf("GraalVM CE does not publish musl builds")
+	}
+
+	ghReleases, err := c.fetchReleases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pick the newest release matching the requested version
+	var best *adoptium.DownloadInfo
+	var bestVersion adoptium.VersionData
+	for _, ghRelease := range ghReleases {
+		versionData, ok := parseTag(ghRelease.TagName)
+		if !ok || ghRelease.Draft || ghRelease.PreRelease || !versionData.Matches(request.Version) {
+			continue
+		}
+		if best != nil && versionData.Compare(bestVersion) <= 0 {
+			continue
+		}
+
+		asset, ok := c.findAsset(ghRelease, platform)
+		if !ok {
+			continue
+		}
+
+		best = &adoptium.DownloadInfo{
+			URL:       asset.BrowserDownloadURL,
+			Filename:  asset.Name,
+			Size:      asset.Size,
+			Vendor:    c.Vendor().Name,
+			Version:   versionData,
+			ImageType: adoptium.ImageTypeJDK,
+			Platform:  platform,
+		}
+
+		// Every archive is published with a <name>.sha256 companion
+		for _, checksumAsset := range ghRelease.Assets {
+			if checksumAsset.Name == asset.Name+".sha256" {
+				best.ChecksumURL = checksumAsset.BrowserDownloadURL
+			}
+		}
+		bestVersion = versionData
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("no suitable GraalVM CE build found for version %s on %s-%s", request.Version, platform.VendorOS(), platform.Arch)
+	}
+
+	return best, nil
+}
+
+// fetchReleases fetches the release list of the GraalVM CE builds repository
+func (c *Client) fetchReleases(ctx context.Context) ([]githubRelease, error) {
+	url := fmt.Sprintf("%s/repos/%s/releases?per_page=100", c.baseURL, releasesRepo)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch GraalVM releases: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+	}
+
+	var releases []githubRelease
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("failed to decode API response: %w", err)
+	}
+
+	return releases, nil
+}
+
+// findAsset returns the archive of a release for a platform, named like
+// graalvm-community-jdk-21.0.2_linux-x64_bin.tar.gz
+func (c *Client) findAsset(release githubRelease, platform adoptium.Platform) (githubAsset, bool) {
+	prefix := fmt.Sprintf("graalvm-community-jdk-%s_%s-%s_bin.",
+		strings.TrimPrefix(release.TagName, "jdk-"), platform.VendorOS(), platform.Arch)
+
+	for _, asset := range release.Assets {
+		if asset.Name == prefix+platform.ArchiveType() {
+			return asset, true
+		}
+	}
+
+	return githubAsset{}, false
+}
+
+// parseTag extracts the version from a release tag such as jdk-21.0.2.
+// Tags of the older vm-22.x release line are not supported.
+func parseTag(tag string) (adoptium.VersionData, bool) {
+	if !strings.HasPrefix(tag, "jdk-") {
+		return adoptium.VersionData{}, false
+	}
+
+	v, err := version.Parse(tag)
+	if err != nil || v.IsPreRelease() {
+		return adoptium.VersionData{}, false
+	}
+
+	return adoptium.NewVersionData(v), true
+}
@@ -0,0 +1,134 @@
+package graalvm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jdk-manager/internal/adoptium"
+)
+
+func TestGetAvailableReleases(t *testing.T) {
+	current := adoptium.CurrentPlatform()
+	platform := fmt.Sprintf("%s-%s_bin.%s", current.VendorOS(), current.Arch, current.ArchiveType())
+
+	// Pre-releases, other platforms and non-JDK tags are skipped
+	releases := []githubRelease{
+		{TagName: "jdk-23.0.0-ea.01", PreRelease: true},
+		{TagName: "jdk-21.0.2", Assets: []githubAsset{
+			{Name: "graalvm-community-jdk-21.0.2_" + platform, BrowserDownloadURL: "https://example.com/21.0.2"},
+		}},
+		{TagName: "jdk-21.0.1", Assets: []githubAsset{
+			{Name: "graalvm-community-jdk-21.0.1_" + platform, BrowserDownloadURL: "https://example.com/21.0.1"},
+		}},
+		{TagName: "jdk-17.0.9", Assets: []githubAsset{
+			{Name: "graalvm-community-jdk-17.0.9_other-platform_bin.tar.gz", BrowserDownloadURL: "https://example.com/17.0.9"},
+		}},
+		{TagName: "vm-22.3.3"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/graalvm/graalvm-ce-builds/releases" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(releases)
+	}))
+	defer server.Close()
+
+	client := NewClient()
+	client.baseURL = server.URL
+
+	available, err := client.GetAvailableReleases(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get releases: %v", err)
+	}
+
+	if len(available) != 2 {
+		t.Fatalf("Expected 2 releases for this platform, got %d", len(available))
+	}
+
+	if available[0].VersionData.Security != 2 || available[1].VersionData.Security != 1 {
+		t.Errorf("Expected 21.0.2 then 21.0.1, got %+v", available)
+	}
+}
+
+func TestGetDownloadInfo(t *testing.T) {
+	current := adoptium.CurrentPlatform()
+	platform := fmt.Sprintf("%s-%s_bin.%s", current.VendorOS(), current.Arch, current.ArchiveType())
+
+	releases := []githubRelease{
+		{TagName: "jdk-21.0.2", Assets: []githubAsset{
+			{Name: "graalvm-community-jdk-21.0.2_" + platform, BrowserDownloadURL: "https://example.com/21.0.2", Size: 300},
+			{Name: "graalvm-community-jdk-21.0.2_" + platform + ".sha256", BrowserDownloadURL: "https://example.com/21.0.2.sha256"},
+		}},
+		{TagName: "jdk-21.0.1", Assets: []githubAsset{
+			{Name: "graalvm-community-jdk-21.0.1_" + platform, BrowserDownloadURL: "https://example.com/21.0.1", Size: 200},
+		}},
+		{TagName: "jdk-17.0.9", Assets: []githubAsset{
+			{Name: "graalvm-community-jdk-17.0.9_other-platform_bin.tar.gz", BrowserDownloadURL: "https://example.com/17.0.9"},
+		}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(releases)
+	}))
+	defer server.Close()
+
+	client := NewClient()
+	client.baseURL = server.URL
+
+	info, err := client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "21"})
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+	if info.URL != "https://example.com/21.0.2" || info.Size != 300 {
+		t.Errorf("Expected latest 21 build, got %+v", info)
+	}
+	if info.ChecksumURL != "https://example.com/21.0.2.sha256" {
+		t.Errorf("Expected checksum URL from the .sha256 asset, got %q", info.ChecksumURL)
+	}
+
+	info, err = client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "21.0.1"})
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+	if info.URL != "https://example.com/21.0.1" {
+		t.Errorf("Expected 21.0.1 build, got %+v", info)
+	}
+
+	if _, err := client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "21", ImageType: adoptium.ImageTypeJRE}); err == nil {
+		t.Error("Expected error for an image type GraalVM CE does not publish")
+	}
+
+	if _, err := client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "17"}); err == nil {
+		t.Error("Expected error when no build exists for this platform")
+	}
+}
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		tag                    string
+		major, minor, security int
+		ok                     bool
+	}{
+		{"jdk-21.0.2", 21, 0, 2, true},
+		{"jdk-22", 22, 0, 0, true},
+		{"jdk-23.0.0-ea.01", 0, 0, 0, false},
+		{"vm-22.3.3", 0, 0, 0, false},
+	}
+
+	for _, test := range tests {
+		v, ok := parseTag(test.tag)
+		if ok != test.ok {
+			t.Errorf("parseTag(%s) ok = %v, expected %v", test.tag, ok, test.ok)
+			continue
+		}
+		if ok && (v.Major != test.major || v.Minor != test.minor || v.Security != test.security) {
+			t.Errorf("parseTag(%s) = %+v", test.tag, v)
+		}
+	}
+}
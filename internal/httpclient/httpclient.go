@@ -0,0 +1,356 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Settings configures retries and timeouts of the shared HTTP transport
+type Settings struct {
+	// MaxRetries is how often a failed request is retried after the first attempt
+	MaxRetries int
+	// InitialBackoff is the delay before the first retry. It doubles with every retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries
+	MaxBackoff time.Duration
+	// MaxRetryAfter is the longest Retry-After delay that is honoured. Responses asking
+	// for a longer wait are returned to the caller instead of being retried.
+	MaxRetryAfter time.Duration
+	// ConnectTimeout limits establishing a connection, including the TLS handshake
+	ConnectTimeout time.Duration
+	// IdleTimeout is how long to wait for response headers or the next body bytes.
+	// Transfers are never limited in total, only stalls abort them.
+	IdleTimeout time.Duration
+	// Proxy, if set, is used instead of the HTTP_PROXY/HTTPS_PROXY environment
+	// variables. Credentials in the URL are sent as basic proxy authentication.
+	Proxy *url.URL
+	// NoProxy lists the hosts reached directly when Proxy is set: host names, domain
+	// suffixes such as .example.com, IP addresses, CIDR ranges or * for all
+	NoProxy []string
+	// RootCAs, if set, replaces the system trust store for TLS connections
+	RootCAs *x509.CertPool
+}
+
+// ErrIdleTimeout is returned when a response stalls for longer than the idle timeout
+var ErrIdleTimeout = errors.New("no data received within the idle timeout")
+
+// DefaultSettings returns the settings used unless Configure is called
+func DefaultSettings() Settings {
+	return Settings{
+		MaxRetries:     3,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+		MaxRetryAfter:  2 * time.Minute,
+		ConnectTimeout: 30 * time.Second,
+		IdleTimeout:    time.Minute,
+	}
+}
+
+var (
+	mu       sync.Mutex
+	settings = DefaultSettings()
+	shared   *http.Transport
+)
+
+// Configure replaces the settings of the shared transport. Clients created
+// before the call keep their settings.
+func Configure(s Settings) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	settings = s
+	shared = nil
+}
+
+// CurrentSettings returns the settings new clients are created with
+func CurrentSettings() Settings {
+	mu.Lock()
+	defer mu.Unlock()
+	return settings
+}
+
+// Transport returns a transport that retries failed requests and enforces the
+// idle timeout. Connections are pooled across all transports returned.
+func Transport() http.RoundTripper {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if shared == nil {
+		shared = newBaseTransport(settings)
+	}
+
+	return &retryTransport{
+		base:     shared,
+		settings: settings,
+	}
+}
+
+// New returns an HTTP client using the shared transport
+func New() *http.Client {
+	return &http.Client{Transport: Transport()}
+}
+
+// newBaseTransport creates the pooled transport that performs the requests
+func newBaseTransport(s Settings) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{
+		Timeout:   s.ConnectTimeout,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+	transport.TLSHandshakeTimeout = s.ConnectTimeout
+	transport.ResponseHeaderTimeout = s.IdleTimeout
+
+	if s.Proxy != nil {
+		transport.Proxy = proxyFunc(s.Proxy, s.NoProxy)
+	}
+	if s.RootCAs != nil {
+		transport.TLSClientConfig = &tls.Config{RootCAs: s.RootCAs}
+	}
+
+	return transport
+}
+
+// LoadCABundle returns the system trust store extended with the PEM encoded
+// certificates in path, e.g. the root of a TLS-inspecting proxy
+func LoadCABundle(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in CA bundle %s", path)
+	}
+
+	return pool, nil
+}
+
+// proxyFunc routes requests through proxy, except for loopback addresses and
+// hosts matching noProxy
+func proxyFunc(proxy *url.URL, noProxy []string) func(*http.Request) (*url.URL, error) {
+	return func(req *http.Request) (*url.URL, error) {
+		if bypassProxy(req.URL.Hostname(), noProxy) {
+			return nil, nil
+		}
+		return proxy, nil
+	}
+}
+
+// bypassProxy reports whether a host is reached without the proxy
+func bypassProxy(host string, noProxy []string) bool {
+	host = strings.ToLower(host)
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	if ip != nil && ip.IsLoopback() {
+		return true
+	}
+
+	for _, entry := range noProxy {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" || entry == host {
+			return true
+		}
+
+		if ip != nil {
+			if _, network, err := net.ParseCIDR(entry); err == nil && network.Contains(ip) {
+				return true
+			}
+			continue
+		}
+
+		// example.com and .example.com both match the domain and its subdomains
+		domain := strings.TrimPrefix(entry, ".")
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryTransport retries requests failing with network errors or retryable
+// status codes, backing off exponentially between attempts
+type retryTransport struct {
+	base     http.RoundTripper
+	settings Settings
+}
+
+// RoundTrip performs a request with retries
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.attempt(req)
+
+		if attempt >= t.settings.MaxRetries || !isIdempotent(req) || !shouldRetry(req.Context(), resp, err) {
+			return resp, err
+		}
+
+		delay := t.backoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp, time.Now()); ok {
+				if retryAfter > t.settings.MaxRetryAfter {
+					return resp, nil
+				}
+				delay = retryAfter
+			}
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// attempt performs a single request whose body read is guarded by the idle timeout
+func (t *retryTransport) attempt(req *http.Request) (*http.Response, error) {
+	if t.settings.IdleTimeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	body := &idleTimeoutBody{body: resp.Body, cancel: cancel}
+	body.timer = time.AfterFunc(t.settings.IdleTimeout, body.expire)
+	body.timeout = t.settings.IdleTimeout
+	resp.Body = body
+	return resp, nil
+}
+
+// backoff returns the delay before the given retry, with jitter so that many
+// clients do not retry in lockstep
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	delay := t.settings.InitialBackoff << attempt
+	if delay > t.settings.MaxBackoff || delay <= 0 {
+		delay = t.settings.MaxBackoff
+	}
+	if delay <= 1 {
+		return delay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)))
+}
+
+// isIdempotent reports whether a request can be sent again safely
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	}
+	return false
+}
+
+// shouldRetry reports whether a failed attempt is worth repeating
+func shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		// Cancellation by the caller is final, everything else may be transient
+		return ctx.Err() == nil
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter reads the Retry-After header of 429 and 503 responses, given
+// either in seconds or as an HTTP date
+func parseRetryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := date.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// idleTimeoutBody aborts a response body that delivers no data for too long
+type idleTimeoutBody struct {
+	body    io.ReadCloser
+	cancel  context.CancelFunc
+	timer   *time.Timer
+	timeout time.Duration
+
+	mu      sync.Mutex
+	expired bool
+}
+
+func (b *idleTimeoutBody) Read(p []byte) (int, error) {
+	n, err := b.body.Read(p)
+
+	b.mu.Lock()
+	expired := b.expired
+	b.mu.Unlock()
+
+	if expired && err != nil && err != io.EOF {
+		return n, fmt.Errorf("%w (%s)", ErrIdleTimeout, b.timeout)
+	}
+	if n > 0 {
+		b.timer.Reset(b.timeout)
+	}
+	return n, err
+}
+
+func (b *idleTimeoutBody) Close() error {
+	b.timer.Stop()
+	err := b.body.Close()
+	b.cancel()
+	return err
+}
+
+// expire cancels the request when the timer fires
+func (b *idleTimeoutBody) expire() {
+	b.mu.Lock()
+	b.expired = true
+	b.mu.Unlock()
+	b.cancel()
+}
@@ -0,0 +1,296 @@
+package httpclient
+
+import (
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fastSettings returns settings with short delays for tests
+func fastSettings() Settings {
+	settings := DefaultSettings()
+	settings.InitialBackoff = time.Millisecond
+	settings.MaxBackoff = 10 * time.Millisecond
+	settings.MaxRetryAfter = 2 * time.Second
+	return settings
+}
+
+func newTestClient(settings Settings) *http.Client {
+	return &http.Client{Transport: &retryTransport{base: newBaseTransport(settings), settings: settings}}
+}
+
+func TestRetryTransport_RetriesServerErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	resp, err := newTestClient(fastSettings()).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || attempts != 3 {
+		t.Errorf("Expected success on the third attempt, got status %d after %d attempts", resp.StatusCode, attempts)
+	}
+}
+
+func TestRetryTransport_GivesUp(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	settings := fastSettings()
+	settings.MaxRetries = 2
+	resp, err := newTestClient(settings).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusInternalServerError || attempts != 3 {
+		t.Errorf("Expected the last error after 3 attempts, got status %d after %d attempts", resp.StatusCode, attempts)
+	}
+}
+
+func TestRetryTransport_ClientErrorsAreFinal(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	resp, err := newTestClient(fastSettings()).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if attempts != 1 {
+		t.Errorf("Expected a single attempt for 404, got %d", attempts)
+	}
+}
+
+func TestRetryTransport_RetryAfter(t *testing.T) {
+	var first time.Time
+	var waited time.Duration
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if first.IsZero() {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		waited = time.Since(first)
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	resp, err := newTestClient(fastSettings()).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected success after Retry-After, got %d", resp.StatusCode)
+	}
+	if waited < time.Second {
+		t.Errorf("Expected to wait for Retry-After, retried after %v", waited)
+	}
+}
+
+func TestRetryTransport_RetryAfterTooLong(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	resp, err := newTestClient(fastSettings()).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable || attempts != 1 {
+		t.Errorf("Expected to give up on a long Retry-After, got status %d after %d attempts", resp.StatusCode, attempts)
+	}
+}
+
+func TestIdleTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("partial"))
+		w.(http.Flusher).Flush()
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	settings := fastSettings()
+	settings.IdleTimeout = 100 * time.Millisecond
+	resp, err := newTestClient(settings).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	_, err = io.ReadAll(resp.Body)
+	if !errors.Is(err, ErrIdleTimeout) {
+		t.Errorf("Expected idle timeout for a stalled body, got %v", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		status   int
+		header   string
+		expected time.Duration
+		ok       bool
+	}{
+		{http.StatusTooManyRequests, "120", 2 * time.Minute, true},
+		{http.StatusServiceUnavailable, now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second, true},
+		{http.StatusServiceUnavailable, now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		{http.StatusServiceUnavailable, "soon", 0, false},
+		{http.StatusBadGateway, "120", 0, false},
+		{http.StatusTooManyRequests, "", 0, false},
+	}
+
+	for _, test := range tests {
+		resp := &http.Response{StatusCode: test.status, Header: http.Header{}}
+		if test.header != "" {
+			resp.Header.Set("Retry-After", test.header)
+		}
+
+		delay, ok := parseRetryAfter(resp, now)
+		if delay != test.expected || ok != test.ok {
+			t.Errorf("parseRetryAfter(%d, %q) = %v, %v; expected %v, %v", test.status, test.header, delay, ok, test.expected, test.ok)
+		}
+	}
+}
+
+func TestProxy(t *testing.T) {
+	var proxiedURL, proxyAuth string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURL = r.URL.String()
+		proxyAuth = r.Header.Get("Proxy-Authorization")
+		w.Write([]byte("via proxy"))
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse proxy URL: %v", err)
+	}
+	proxyURL.User = url.UserPassword("alice", "secret")
+
+	settings := fastSettings()
+	settings.Proxy = proxyURL
+	resp, err := newTestClient(settings).Get("http://api.example.com/v3/info")
+	if err != nil {
+		t.Fatalf("Request through proxy failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if proxiedURL != "http://api.example.com/v3/info" {
+		t.Errorf("Expected the proxy to receive the absolute URL, got %s", proxiedURL)
+	}
+	if proxyAuth != "Basic YWxpY2U6c2VjcmV0" {
+		t.Errorf("Expected basic proxy authentication, got %q", proxyAuth)
+	}
+}
+
+func TestBypassProxy(t *testing.T) {
+	noProxy := []string{"internal.example.com", ".corp.example", "10.0.0.0/8", "192.168.1.5"}
+
+	tests := []struct {
+		host     string
+		expected bool
+	}{
+		{"localhost", true},
+		{"127.0.0.1", true},
+		{"internal.example.com", true},
+		{"mirror.internal.example.com", true},
+		{"corp.example", true},
+		{"nexus.corp.example", true},
+		{"10.1.2.3", true},
+		{"192.168.1.5", true},
+		{"192.168.1.6", false},
+		{"api.adoptium.net", false},
+		{"notinternal.example.com", false},
+	}
+
+	for _, test := range tests {
+		if result := bypassProxy(test.host, noProxy); result != test.expected {
+			t.Errorf("bypassProxy(%q) = %v, expected %v", test.host, result, test.expected)
+		}
+	}
+
+	if !bypassProxy("api.adoptium.net", []string{"*"}) {
+		t.Error("Expected * to bypass the proxy for every host")
+	}
+}
+
+func TestLoadCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	settings := fastSettings()
+	settings.MaxRetries = 0
+	if _, err := newTestClient(settings).Get(server.URL); err == nil {
+		t.Fatal("Expected the test certificate to be untrusted by default")
+	}
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(bundle, certPEM, 0644); err != nil {
+		t.Fatalf("Failed to write CA bundle: %v", err)
+	}
+
+	pool, err := LoadCABundle(bundle)
+	if err != nil {
+		t.Fatalf("Failed to load CA bundle: %v", err)
+	}
+
+	settings.RootCAs = pool
+	resp, err := newTestClient(settings).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Expected the certificate from the bundle to be trusted: %v", err)
+	}
+	resp.Body.Close()
+
+	if err := os.WriteFile(bundle, []byte("not a certificate"), 0644); err != nil {
+		t.Fatalf("Failed to write CA bundle: %v", err)
+	}
+	if _, err := LoadCABundle(bundle); err == nil {
+		t.Error("Expected error for a bundle without certificates")
+	}
+}
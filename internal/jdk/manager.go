@@ -1,21 +1,51 @@
 package jdk
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/lock"
 	"github.com/jdk-manager/internal/utils"
 	"github.com/mitchellh/go-homedir"
 )
 
+// DefaultVendor is the distribution whose installs are stored under the bare version name
+const DefaultVendor = "temurin"
+
+// LocksDir is the directory of the store that holds the lock files of running installs
+const LocksDir = ".locks"
+
+// DefaultLockTimeout is how long an install waits for another jdk process working on
+// the same version
+const DefaultLockTimeout = 10 * time.Minute
+
+// storeDirs are the directories of ~/.jdks that hold jdk-manager's own data rather
+// than installs
+var storeDirs = map[string]bool{"current": true, "platforms": true, "cache": true, "keys": true}
+
+// ErrAlreadyInstalled is returned by Install for a version that is already installed
+var ErrAlreadyInstalled = errors.New("already installed")
+
+// SignatureVerifier checks a detached signature of a downloaded archive
+type SignatureVerifier interface {
+	Verify(filePath, signaturePath string) error
+}
+
 // Manager handles JDK installation and management
 type Manager struct {
-	jdksDir string
-	symlinkPath string // New field for the 'current' symlink path
+	jdksDir     string
+	symlinkPath string            // New field for the 'current' symlink path
+	verifier    SignatureVerifier // Signature checks are skipped when nil
+	platform    adoptium.Platform // Platform of the managed installs
+	lockTimeout time.Duration     // How long to wait for a locked version
 }
 
 // NewManager creates a new JDK manager instance
@@ -36,53 +66,195 @@ func NewManager() (*Manager, error) {
 	return &Manager{
 		jdksDir: jdksDir,
 		symlinkPath: symlinkPath,
+		platform:    adoptium.CurrentPlatform(),
+		lockTimeout: DefaultLockTimeout,
+	}, nil
+}
+
+// NewManagerForPlatform creates a manager for installs of another platform. They are
+// kept apart in ~/.jdks/platforms/<os>-<arch>[-musl] and cannot be activated with use.
+// For the current platform this is the same as NewManager.
+func NewManagerForPlatform(platform adoptium.Platform) (*Manager, error) {
+	manager, err := NewManager()
+	if err != nil || platform.IsCurrent() {
+		return manager, err
+	}
+
+	jdksDir := filepath.Join(manager.jdksDir, "platforms", platform.String())
+	if err := os.MkdirAll(jdksDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create JDKs directory: %w", err)
+	}
+
+	return &Manager{
+		jdksDir:     jdksDir,
+		symlinkPath: filepath.Join(jdksDir, "current"),
+		platform:    platform,
+		lockTimeout: manager.lockTimeout,
 	}, nil
 }
 
+// InstallName returns the directory name used for a vendor's version, so that builds
+// of the same version from different distributions never overwrite each other.
+// Installs of the default vendor keep the plain version name (e.g. "21"), others
+// are prefixed with the vendor (e.g. "corretto-21"). Image types other than jdk
+// are appended (e.g. "21-jre").
+func InstallName(vendor, version, imageType string) string {
+	name := version
+	if vendor != "" && vendor != DefaultVendor {
+		name = vendor + "-" + name
+	}
+	if imageType != "" && imageType != adoptium.ImageTypeJDK {
+		name += "-" + imageType
+	}
+	return name
+}
+
+// ParseInstallName splits a name built by InstallName into vendor, version and image type.
+// The vendor is empty for the default vendor, the image type empty for a full JDK.
+func ParseInstallName(name string) (vendor, version, imageType string) {
+	parts := strings.Split(name, "-")
+	if len(parts) > 1 && (parts[0] == "" || parts[0][0] < '0' || parts[0][0] > '9') {
+		vendor, parts = parts[0], parts[1:]
+	}
+	if last := parts[len(parts)-1]; len(parts) > 1 && last != adoptium.ImageTypeJDK {
+		for _, supported := range adoptium.ImageTypes {
+			if last == supported {
+				imageType, parts = last, parts[:len(parts)-1]
+				break
+			}
+		}
+	}
+	return vendor, strings.Join(parts, "-"), imageType
+}
+
+// SetSignatureVerifier enables signature verification of downloaded archives
+func (m *Manager) SetSignatureVerifier(verifier SignatureVerifier) {
+	m.verifier = verifier
+}
+
+// SetLockTimeout sets how long installs and uninstalls wait for another jdk process
+// working on the same version. With 0 they fail right away.
+func (m *Manager) SetLockTimeout(timeout time.Duration) {
+	m.lockTimeout = timeout
+}
+
 // GetJDKsDir returns the JDKs installation directory
 func (m *Manager) GetJDKsDir() string {
 	return m.jdksDir
 }
 
+// GetPlatform returns the platform of the installs handled by the manager
+func (m *Manager) GetPlatform() adoptium.Platform {
+	return m.platform
+}
+
 // GetSymlinkPath returns the path to the 'current' symlink
 func (m *Manager) GetSymlinkPath() string {
 	return m.symlinkPath
 }
 
-// ListInstalled returns a list of installed JDK versions
+// ListInstalled returns a list of installed JDK versions, oldest version first
 func (m *Manager) ListInstalled() ([]string, error) {
+	installations, err := m.Installations()
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	for _, installation := range installations {
+		versions = append(versions, installation.Name)
+	}
+
+	return versions, nil
+}
+
+// Installations returns the installed versions with their manifests, oldest version first.
+// Installs that cannot be read are skipped with a warning, so one broken directory does
+// not hide the others.
+func (m *Manager) Installations() ([]Installation, error) {
 	entries, err := os.ReadDir(m.jdksDir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read JDKs directory: %w", err)
 	}
 
-	var versions []string
+	var installations []Installation
 	for _, entry := range entries {
-		if entry.IsDir() && entry.Name() != "current" { // Exclude the 'current' symlink directory
-			// Verify it's a valid JDK installation
-			jdkPath := filepath.Join(m.jdksDir, entry.Name())
-			if m.isValidJDK(jdkPath) {
-				versions = append(versions, entry.Name())
+		// Exclude the 'current' symlink directory and staged or previous installs
+		if entry.IsDir() && entry.Name() != "current" && !strings.HasPrefix(entry.Name(), ".") {
+			installation, err := m.GetInstallation(entry.Name())
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: skipping %s: %v\n", entry.Name(), err)
+				continue
+			}
+			if installation != nil {
+				installations = append(installations, *installation)
 			}
 		}
 	}
 
-	return versions, nil
+	sortInstallations(installations)
+	return installations, nil
+}
+
+// GetInstallation returns an installed version with its manifest, or nil if it is not installed
+func (m *Manager) GetInstallation(name string) (*Installation, error) {
+	jdkPath := filepath.Join(m.jdksDir, name)
+	if info, err := os.Stat(jdkPath); err != nil || !info.IsDir() {
+		return nil, nil
+	}
+
+	// Verify it's a valid JDK installation
+	installation, err := m.inspect(jdkPath)
+	if err != nil || installation == nil {
+		return nil, err
+	}
+
+	installation.Name = name
+	return installation, nil
+}
+
+// sortInstallations sorts installations by version, oldest first. Installations of the
+// same version are sorted by name, those without a known version come last.
+func sortInstallations(installations []Installation) {
+	sort.SliceStable(installations, func(i, j int) bool {
+		a, errA := installations[i].Version()
+		b, errB := installations[j].Version()
+
+		switch {
+		case errA != nil || errB != nil:
+			if (errA == nil) != (errB == nil) {
+				return errA == nil
+			}
+		case a.Compare(b) != 0:
+			return a.Compare(b) < 0
+		}
+		return installations[i].Name < installations[j].Name
+	})
+}
+
+// HasInstallDir reports whether name is a directory of the store that may hold an
+// install, valid or not, as opposed to the store's own data
+func (m *Manager) HasInstallDir(name string) bool {
+	if name != filepath.Base(name) || strings.HasPrefix(name, ".") || storeDirs[name] {
+		return false
+	}
+	info, err := os.Stat(filepath.Join(m.jdksDir, name))
+	return err == nil && info.IsDir()
 }
 
 // IsInstalled checks if a specific JDK version is installed
 func (m *Manager) IsInstalled(version string) (bool, error) {
-	jdkPath := filepath.Join(m.jdksDir, version)
-	
-	// Check if directory exists and contains a valid JDK
-	if _, err := os.Stat(jdkPath); os.IsNotExist(err) {
-		return false, nil
-	}
+	installation, err := m.GetInstallation(version)
+	return installation != nil, err
+}
 
-	return m.isValidJDK(jdkPath), nil
+// GetMetadata returns the recorded metadata of an installed version
+func (m *Manager) GetMetadata(version string) (*Metadata, error) {
+	return readMetadata(filepath.Join(m.jdksDir, version))
 }
 
-// GetJDKPath returns the full path to a specific JDK version
+// GetJDKPath returns the Java home of a specific JDK version, the directory
+// JAVA_HOME has to point at
 func (m *Manager) GetJDKPath(version string) (string, error) {
 	jdkPath := filepath.Join(m.jdksDir, version)
 	
@@ -90,59 +262,276 @@ func (m *Manager) GetJDKPath(version string) (string, error) {
 		return "", fmt.Errorf("JDK %s is not properly installed", version)
 	}
 
-	return jdkPath, nil
+	return JavaHome(jdkPath), nil
 }
 
-// Install downloads and installs a JDK version
-func (m *Manager) Install(version string, downloadInfo *adoptium.DownloadInfo) error {
-	installPath := filepath.Join(m.jdksDir, version)
-	
-	// Remove existing installation if it exists
-	if _, err := os.Stat(installPath); err == nil {
-		if err := os.RemoveAll(installPath); err != nil {
-			return fmt.Errorf("failed to remove existing installation: %w", err)
+// Install downloads and installs a JDK version. The install is staged in a hidden
+// directory of the store and only moved into place once it has been verified, so a
+// failed reinstall leaves the previous install untouched. An existing install is only
+// replaced with force, otherwise ErrAlreadyInstalled is returned.
+func (m *Manager) Install(ctx context.Context, version string, downloadInfo *adoptium.DownloadInfo, force bool) error {
+	versionLock, err := m.lockVersion(ctx, version)
+	if err != nil {
+		return err
+	}
+	defer versionLock.Release()
+
+	// Another process may have installed the version while this one waited for the lock
+	if !force {
+		installed, err := m.IsInstalled(version)
+		if err != nil {
+			return err
+		}
+		if installed {
+			return ErrAlreadyInstalled
 		}
 	}
 
-	// Create temporary directory for download
-	tempDir, err := os.MkdirTemp("", "jdk-install-*")
+	// Clean up after an earlier install of this version that was killed
+	if err := m.recoverInstall(version); err != nil {
+		return err
+	}
+
+	// Stage next to the store, so the install can be moved into place with a rename
+	stagingDir, err := os.MkdirTemp(m.jdksDir, stagingPrefix+"*-"+version)
 	if err != nil {
-		return fmt.Errorf("failed to create temp directory: %w", err)
+		return fmt.Errorf("failed to create staging directory: %w", err)
 	}
-	defer os.RemoveAll(tempDir)
+	defer os.RemoveAll(stagingDir)
 
 	// Download the JDK archive
-	archivePath := filepath.Join(tempDir, downloadInfo.Filename)
+	archivePath := filepath.Join(stagingDir, downloadInfo.Filename)
 	fmt.Printf("Downloading %s...\n", downloadInfo.Filename)
 	
-	if err := utils.DownloadFile(downloadInfo.URL, archivePath); err != nil {
+	// Configured mirrors are tried first, the upstream URL last
+	urls := append(append([]string(nil), downloadInfo.Mirrors...), downloadInfo.URL)
+	if err := utils.DownloadFileFromURLs(ctx, urls, archivePath); err != nil {
 		return fmt.Errorf("failed to download JDK: %w", err)
 	}
 
+	// Verify the archive before anything is extracted from it
+	checksum, err := m.verifyDownload(ctx, archivePath, downloadInfo)
+	if err != nil {
+		os.Remove(archivePath)
+		return fmt.Errorf("verification of %s failed: %w", downloadInfo.Filename, err)
+	}
+	archiveInfo, err := os.Stat(archivePath)
+	if err != nil {
+		return fmt.Errorf("failed to stat downloaded archive: %w", err)
+	}
+
+	if err := m.verifySignature(ctx, archivePath, downloadInfo); err != nil {
+		os.Remove(archivePath)
+		return fmt.Errorf("signature check of %s failed: %w", downloadInfo.Filename, err)
+	}
+
 	// Extract the archive
 	fmt.Println("Extracting JDK...")
-	extractedPath, err := utils.ExtractArchive(archivePath, tempDir)
+	extractedPath, err := utils.ExtractArchive(archivePath, stagingDir)
 	if err != nil {
 		return fmt.Errorf("failed to extract JDK: %w", err)
 	}
 
-	// Move to final location
-	if err := os.Rename(extractedPath, installPath); err != nil {
-		return fmt.Errorf("failed to move JDK to installation directory: %w", err)
+	metadata := &Metadata{
+		Vendor:      downloadInfo.Vendor,
+		Build:       downloadInfo.Version.Build,
+		ImageType:   downloadInfo.ImageType,
+		Platform:    downloadInfo.Platform,
+		URL:         downloadInfo.URL,
+		Checksum:    checksum,
+		Size:        archiveInfo.Size(),
+		InstalledAt: time.Now().UTC().Truncate(time.Second),
+	}
+	if downloadInfo.Version.Major > 0 {
+		metadata.Version = downloadInfo.Version.Version().String()
+	}
+	if metadata.ImageType == "" {
+		metadata.ImageType = adoptium.ImageTypeJDK
+	}
+	if metadata.OS == "" {
+		metadata.Platform = m.platform
+	}
+	if err := writeMetadata(extractedPath, metadata); err != nil {
+		return err
 	}
 
-	// Verify installation
-	if !m.isValidJDK(installPath) {
+	// Verify the staged installation before it replaces anything
+	if !m.isValidJDK(extractedPath) {
 		return fmt.Errorf("JDK installation verification failed")
 	}
 
+	// Extraction is not interruptible, give up before anything is moved into place
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return m.replaceInstall(extractedPath, version)
+}
+
+// lockVersion takes the lock of a version for the duration of a change, waiting for
+// other jdk processes working on the same version. Other versions are not blocked.
+func (m *Manager) lockVersion(ctx context.Context, version string) (*lock.Lock, error) {
+	locks := lock.NewStore(filepath.Join(m.jdksDir, LocksDir))
+	return locks.Lock(ctx, version, m.lockTimeout, func(owner lock.Owner) {
+		fmt.Printf("Waiting for %s, which is changing JDK %s...\n", owner, version)
+	})
+}
+
+// stagingPrefix and backupPrefix start the names of the hidden directories in the store
+// that installs are staged in and that previous installs are kept in during a reinstall.
+// Staging directories are named .staging-<random>-<version>.
+const (
+	stagingPrefix = ".staging-"
+	backupPrefix  = ".previous-"
+)
+
+// replaceInstall moves a staged install into place. An existing install is moved aside
+// first, restored if the staged one cannot be moved in and removed once it is.
+func (m *Manager) replaceInstall(stagedPath, version string) error {
+	installPath := filepath.Join(m.jdksDir, version)
+	backupPath := filepath.Join(m.jdksDir, backupPrefix+version)
+
+	if _, err := os.Lstat(installPath); err == nil {
+		if err := os.Rename(installPath, backupPath); err != nil {
+			return fmt.Errorf("failed to move existing installation aside: %w", err)
+		}
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to check existing installation: %w", err)
+	}
+
+	if err := os.Rename(stagedPath, installPath); err != nil {
+		if restoreErr := os.Rename(backupPath, installPath); restoreErr != nil && !os.IsNotExist(restoreErr) {
+			return fmt.Errorf("failed to move JDK to installation directory: %w (previous installation kept in %s)", err, backupPath)
+		}
+		return fmt.Errorf("failed to move JDK to installation directory: %w", err)
+	}
+
+	if err := os.RemoveAll(backupPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to remove previous installation %s: %v\n", backupPath, err)
+	}
+	return nil
+}
+
+// recoverInstall cleans up after an install of a version that was killed. Its staging
+// directories are removed, and a replacement interrupted between its two renames is
+// finished: the previous install is restored if nothing took its place, and removed
+// otherwise. The caller must hold the version lock.
+func (m *Manager) recoverInstall(version string) error {
+	installPath := filepath.Join(m.jdksDir, version)
+	backupPath := filepath.Join(m.jdksDir, backupPrefix+version)
+
+	entries, err := os.ReadDir(m.jdksDir)
+	if err != nil {
+		return fmt.Errorf("failed to read JDKs directory: %w", err)
+	}
+	for _, entry := range entries {
+		name, ok := strings.CutPrefix(entry.Name(), stagingPrefix)
+		if _, staged, _ := strings.Cut(name, "-"); ok && staged == version {
+			if err := os.RemoveAll(filepath.Join(m.jdksDir, entry.Name())); err != nil {
+				return fmt.Errorf("failed to remove staging directory: %w", err)
+			}
+		}
+	}
+
+	if _, err := os.Lstat(backupPath); err != nil {
+		return nil
+	}
+
+	if _, err := os.Lstat(installPath); os.IsNotExist(err) {
+		if err := os.Rename(backupPath, installPath); err != nil {
+			return fmt.Errorf("failed to restore previous installation: %w", err)
+		}
+		return nil
+	}
+
+	if err := os.RemoveAll(backupPath); err != nil {
+		return fmt.Errorf("failed to remove previous installation: %w", err)
+	}
+	return nil
+}
+
+// verifyDownload checks the archive size and SHA-256 checksum published by the provider.
+// It returns the checksum of the archive, computed locally if none is published.
+func (m *Manager) verifyDownload(ctx context.Context, archivePath string, downloadInfo *adoptium.DownloadInfo) (string, error) {
+	checksum := downloadInfo.Checksum
+	if checksum == "" && downloadInfo.ChecksumURL != "" {
+		fetched, err := utils.FetchChecksum(ctx, downloadInfo.ChecksumURL)
+		if err != nil {
+			return "", err
+		}
+		checksum = fetched
+	}
+
+	if checksum == "" {
+		fmt.Fprintf(os.Stderr, "Warning: no checksum published for %s, skipping checksum verification\n", downloadInfo.Filename)
+		if err := utils.VerifyFile(archivePath, downloadInfo.Size, ""); err != nil {
+			return "", err
+		}
+		return utils.FileSHA256(archivePath)
+	}
+
+	fmt.Println("Verifying checksum...")
+	if err := utils.VerifyFile(archivePath, downloadInfo.Size, checksum); err != nil {
+		return "", err
+	}
+	return strings.ToLower(checksum), nil
+}
+
+// verifySignature checks the archive against its published detached signature
+func (m *Manager) verifySignature(ctx context.Context, archivePath string, downloadInfo *adoptium.DownloadInfo) error {
+	if m.verifier == nil {
+		return nil
+	}
+
+	if downloadInfo.SignatureURL == "" {
+		fmt.Fprintf(os.Stderr, "Warning: no signature published for %s, skipping signature verification\n", downloadInfo.Filename)
+		return nil
+	}
+
+	fmt.Println("Verifying signature...")
+	signature, err := utils.FetchSignature(ctx, downloadInfo.SignatureURL)
+	if err != nil {
+		return err
+	}
+
+	signaturePath := archivePath + ".sig"
+	if err := os.WriteFile(signaturePath, signature, 0644); err != nil {
+		return fmt.Errorf("failed to save signature: %w", err)
+	}
+	defer os.Remove(signaturePath)
+
+	return m.verifier.Verify(archivePath, signaturePath)
+}
+
+// Export copies an installed version to dest, leaving out jdk-manager's own files
+func (m *Manager) Export(version, dest string) error {
+	jdkPath := filepath.Join(m.jdksDir, version)
+	if !m.isValidJDK(jdkPath) {
+		return fmt.Errorf("JDK %s is not properly installed", version)
+	}
+
+	skip := func(rel string) bool { return rel == MetadataFile }
+	if err := utils.CopyDir(jdkPath, dest, skip); err != nil {
+		return fmt.Errorf("failed to export JDK %s: %w", version, err)
+	}
+
 	return nil
 }
 
-// Uninstall removes a specific JDK version
-func (m *Manager) Uninstall(version string) error {
+// Uninstall removes a specific JDK version, waiting for a running install of it
+func (m *Manager) Uninstall(ctx context.Context, version string) error {
 	jdkPath := filepath.Join(m.jdksDir, version)
 
+	versionLock, err := m.lockVersion(ctx, version)
+	if err != nil {
+		return err
+	}
+	defer versionLock.Release()
+
+	if err := m.recoverInstall(version); err != nil {
+		return err
+	}
+
 	// Check if the directory exists
 	if _, err := os.Stat(jdkPath); os.IsNotExist(err) {
 		return fmt.Errorf("JDK %s is not installed at %s", version, jdkPath)
@@ -201,7 +590,13 @@ func (m *Manager) GenerateSymlinkCommands(targetJDKPath string) {
 	symlinkPath := m.GetSymlinkPath()
 	symlinkBinPath := filepath.Join(symlinkPath, "bin")
 
-	fmt.Printf("# Commands to activate JDK %s:\n", filepath.Base(targetJDKPath))
+	// The target may be the Contents/Home bundle of a macOS build
+	name := filepath.Base(targetJDKPath)
+	if rel, err := filepath.Rel(m.jdksDir, targetJDKPath); err == nil {
+		name = strings.Split(rel, string(filepath.Separator))[0]
+	}
+
+	fmt.Printf("# Commands to activate JDK %s:\n", name)
 
 	// Remove existing symlink if it exists
 	switch runtime.GOOS {
@@ -213,11 +608,23 @@ func (m *Manager) GenerateSymlinkCommands(targetJDKPath string) {
 		// Set JAVA_HOME and PATH
 		fmt.Printf("$env:JAVA_HOME = \"%s\"\n", symlinkPath)
 		fmt.Printf("$env:PATH = \"%s;$env:PATH\"\n", symlinkBinPath)
+		// GraalVM tooling (native-image, Maven/Gradle plugins) looks for GRAALVM_HOME
+		if isGraalVM(targetJDKPath) {
+			fmt.Printf("$env:GRAALVM_HOME = \"%s\"\n", symlinkPath)
+		} else {
+			fmt.Printf("Remove-Item Env:GRAALVM_HOME -ErrorAction SilentlyContinue\n")
+		}
 	default: // Linux, macOS
 		fmt.Printf("rm -f \"%s\"\n", symlinkPath) // Remove existing symlink
 		fmt.Printf("ln -s \"%s\" \"%s\"\n", targetJDKPath, symlinkPath) // Create new symlink
 		fmt.Printf("export JAVA_HOME=\"%s\"\n", symlinkPath)
 		fmt.Printf("export PATH=\"$JAVA_HOME/bin:$PATH\"\n")
+		// GraalVM tooling (native-image, Maven/Gradle plugins) looks for GRAALVM_HOME
+		if isGraalVM(targetJDKPath) {
+			fmt.Printf("export GRAALVM_HOME=\"%s\"\n", symlinkPath)
+		} else {
+			fmt.Printf("unset GRAALVM_HOME\n")
+		}
 	}
 }
 
@@ -229,42 +636,121 @@ func (m *Manager) GenerateClearEnvCommands() {
 	switch runtime.GOOS {
 	case "windows":
 		fmt.Printf("$env:JAVA_HOME = \"\"\n")
+		fmt.Printf("Remove-Item Env:GRAALVM_HOME -ErrorAction SilentlyContinue\n")
 		fmt.Printf("$env:PATH = ($env:PATH -split ';') -notmatch '%s'\n", strings.ReplaceAll(symlinkPath, `\`, `\\`)) // Remove symlink path from PATH
 		fmt.Printf("cmd /C rmdir /S /Q \"%s\" 2>$null\n", symlinkPath)
 	default: // Linux, macOS
 		fmt.Printf("unset JAVA_HOME\n")
+		fmt.Printf("unset GRAALVM_HOME\n")
 		fmt.Printf("export PATH=$(echo $PATH | sed -e 's|%s/bin:||g')\n", symlinkPath) // Remove symlink path from PATH
 		fmt.Printf("rm -f \"%s\"\n", symlinkPath)
 	}
 }
 
-// isValidJDK checks if a directory contains a valid JDK installation
+// isValidJDK checks if a directory contains a valid installation of its recorded image type
 func (m *Manager) isValidJDK(jdkPath string) bool {
-	// Check for java executable
-	var javaExe string
-	if runtime.GOOS == "windows" {
-		javaExe = "java.exe"
-	} else {
-		javaExe = "java"
+	installation, err := m.inspect(jdkPath)
+	return err == nil && installation != nil
+}
+
+// inspect reads the manifest and release file of a directory, returning nil unless it
+// contains a valid installation of its recorded image type. A manifest that cannot be
+// read is an error, so the install is reported rather than silently left out.
+func (m *Manager) inspect(jdkPath string) (*Installation, error) {
+	md, err := readMetadata(jdkPath)
+	if err != nil {
+		return nil, err
 	}
 
-	javaPath := filepath.Join(jdkPath, "bin", javaExe)
-	if _, err := os.Stat(javaPath); err != nil {
-		return false
+	// A release file that cannot be read is reported, not held against the install.
+	// One that names another platform means the build cannot run here.
+	release, releaseErr := ReadRelease(jdkPath)
+	if release != nil && !m.matchesPlatform(release) {
+		return nil, nil
 	}
 
-	// Check for javac executable (to ensure it's a JDK, not just JRE)
-	var javacExe string
-	if runtime.GOOS == "windows" {
-		javacExe = "javac.exe"
-	} else {
-		javacExe = "javac"
+	if !m.hasContent(jdkPath, md.ImageType) {
+		return nil, nil
 	}
 
-	javacPath := filepath.Join(jdkPath, "bin", javacExe)
-	if _, err := os.Stat(javacPath); err != nil {
+	return &Installation{
+		Name:         filepath.Base(jdkPath),
+		Path:         jdkPath,
+		Metadata:     md,
+		Release:      release,
+		ReleaseError: releaseErr,
+	}, nil
+}
+
+// hasContent checks that an installation contains what its image type ships
+func (m *Manager) hasContent(jdkPath, imageType string) bool {
+	switch imageType {
+	case adoptium.ImageTypeJDK:
+		// A JDK ships the compiler next to the runtime
+		return m.hasExecutable(jdkPath, "java") && m.hasExecutable(jdkPath, "javac")
+	case adoptium.ImageTypeJRE:
+		return m.hasExecutable(jdkPath, "java")
+	default:
+		// Debug images, static libraries and sources have no executables to check,
+		// but must contain more than the manifest
+		entries, err := os.ReadDir(jdkPath)
+		if err != nil {
+			return false
+		}
+		for _, entry := range entries {
+			if entry.Name() != MetadataFile {
+				return true
+			}
+		}
 		return false
 	}
+}
+
+// matchesPlatform reports whether a release file names the manager's platform.
+// Release files that do not record the platform match any.
+func (m *Manager) matchesPlatform(release *Release) bool {
+	platform, ok := release.Platform()
+	if !ok || m.platform.OS == "" {
+		return true
+	}
+	return platform.OS == m.platform.OS && platform.Arch == m.platform.Arch
+}
+
+// JavaHome returns the directory of an installation that JAVA_HOME points at:
+// the Contents/Home bundle of macOS builds, otherwise the installation itself
+func JavaHome(installPath string) string {
+	home := filepath.Join(installPath, "Contents", "Home")
+	if info, err := os.Stat(home); err == nil && info.IsDir() {
+		return home
+	}
+	return installPath
+}
+
+// hasExecutable checks for an executable in the bin directory of an installation,
+// named for the manager's platform
+func (m *Manager) hasExecutable(jdkPath, name string) bool {
+	if m.platform.OS == "windows" {
+		name += ".exe"
+	}
+
+	_, err := os.Stat(filepath.Join(JavaHome(jdkPath), "bin", name))
+	return err == nil
+}
+
+// isGraalVM checks if a JDK installation is a GraalVM distribution
+func isGraalVM(jdkPath string) bool {
+	jdkPath = JavaHome(jdkPath)
+
+	// GraalVM ships the Substrate VM (native-image) under lib/svm
+	if info, err := os.Stat(filepath.Join(jdkPath, "lib", "svm")); err == nil && info.IsDir() {
+		return true
+	}
+
+	nativeImage := "native-image"
+	if runtime.GOOS == "windows" {
+		nativeImage = "native-image.cmd"
+	}
 
-	return true
+	_, err := os.Stat(filepath.Join(jdkPath, "bin", nativeImage))
+	return err == nil
 }
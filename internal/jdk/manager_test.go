@@ -1,9 +1,25 @@
 package jdk
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
+
+	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/lock"
+	"github.com/mitchellh/go-homedir"
 )
 
 func TestNewManager(t *testing.T) {
@@ -42,6 +58,36 @@ func TestGetJDKsDir(t *testing.T) {
 	}
 }
 
+func TestNewManagerForPlatform(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("USERPROFILE", os.Getenv("HOME"))
+	homedir.DisableCache = true
+	t.Cleanup(func() { homedir.DisableCache = false; homedir.Reset() })
+
+	local, err := NewManager()
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+
+	platforms := map[adoptium.Platform]string{
+		{OS: "windows", Arch: "x64"}:                "windows-x64",
+		{OS: "linux", Arch: "x64", LibC: "musl"}:    "linux-x64-musl",
+		{OS: "linux", Arch: "s390x", LibC: "glibc"}: "linux-s390x",
+	}
+	for platform, dir := range platforms {
+		if platform.IsCurrent() {
+			continue
+		}
+		manager, err := NewManagerForPlatform(platform)
+		if err != nil {
+			t.Fatalf("Failed to create manager for %s: %v", platform, err)
+		}
+		if expected := filepath.Join(local.GetJDKsDir(), "platforms", dir); manager.GetJDKsDir() != expected {
+			t.Errorf("Expected %s installs in %s, got %s", platform, expected, manager.GetJDKsDir())
+		}
+	}
+}
+
 func TestListInstalled_EmptyDirectory(t *testing.T) {
 	manager, err := NewManager()
 	if err != nil {
@@ -96,3 +142,542 @@ func TestIsValidJDK(t *testing.T) {
 		t.Fatal("Empty directory should not be valid JDK")
 	}
 }
+
+func TestInstallName(t *testing.T) {
+	tests := []struct {
+		vendor    string
+		version   string
+		imageType string
+		expected  string
+	}{
+		{"", "21", "", "21"},
+		{"temurin", "17.0.8", "jdk", "17.0.8"},
+		{"corretto", "21", "jdk", "corretto-21"},
+		{"temurin", "21", "jre", "21-jre"},
+		{"zulu", "17", "jre", "zulu-17-jre"},
+		{"temurin", "25-ea", "debugimage", "25-ea-debugimage"},
+	}
+
+	for _, test := range tests {
+		result := InstallName(test.vendor, test.version, test.imageType)
+		if result != test.expected {
+			t.Errorf("InstallName(%s, %s, %s) = %s, expected %s",
+				test.vendor, test.version, test.imageType, result, test.expected)
+		}
+	}
+}
+
+func TestParseInstallName(t *testing.T) {
+	tests := []struct {
+		name      string
+		vendor    string
+		version   string
+		imageType string
+	}{
+		{"21", "", "21", ""},
+		{"17.0.8", "", "17.0.8", ""},
+		{"corretto-21", "corretto", "21", ""},
+		{"21-jre", "", "21", "jre"},
+		{"zulu-17-jre", "zulu", "17", "jre"},
+		{"25-ea-debugimage", "", "25-ea", "debugimage"},
+		{"sap_machine-25-ea", "sap_machine", "25-ea", ""},
+	}
+
+	for _, test := range tests {
+		vendor, version, imageType := ParseInstallName(test.name)
+		if vendor != test.vendor || version != test.version || imageType != test.imageType {
+			t.Errorf("ParseInstallName(%s) = %s, %s, %s; expected %s, %s, %s",
+				test.name, vendor, version, imageType, test.vendor, test.version, test.imageType)
+		}
+		if name := InstallName(vendor, version, imageType); name != test.name {
+			t.Errorf("InstallName does not round-trip %s, got %s", test.name, name)
+		}
+	}
+}
+
+func TestSortInstallations(t *testing.T) {
+	var installations []Installation
+	for _, name := range []string{"21", "zulu-17-jre", "8", "17.0.10", "corretto-21", "17.0.8", "25-ea", "custom", "11"} {
+		installations = append(installations, Installation{Name: name, Metadata: &Metadata{}})
+	}
+	// The manifest takes precedence over the directory name
+	installations[0].Metadata.Version = "21.0.2+13"
+
+	sortInstallations(installations)
+
+	var names []string
+	for _, installation := range installations {
+		names = append(names, installation.Name)
+	}
+	expected := []string{"8", "11", "zulu-17-jre", "17.0.8", "17.0.10", "corretto-21", "21", "25-ea", "custom"}
+	if strings.Join(names, " ") != strings.Join(expected, " ") {
+		t.Errorf("sortInstallations = %v, expected %v", names, expected)
+	}
+}
+
+func TestIsValidJDK_ImageTypes(t *testing.T) {
+	manager := &Manager{jdksDir: t.TempDir(), platform: adoptium.CurrentPlatform()}
+	installPath := filepath.Join(manager.jdksDir, "21-jre")
+
+	java := "java"
+	if runtime.GOOS == "windows" {
+		java = "java.exe"
+	}
+	if err := os.MkdirAll(filepath.Join(installPath, "bin"), 0755); err != nil {
+		t.Fatalf("Failed to create bin directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(installPath, "bin", java), nil, 0755); err != nil {
+		t.Fatalf("Failed to create java executable: %v", err)
+	}
+
+	// Without metadata the install is expected to be a full JDK
+	if manager.isValidJDK(installPath) {
+		t.Fatal("Runtime without javac should not be a valid JDK")
+	}
+
+	if err := writeMetadata(installPath, &Metadata{ImageType: adoptium.ImageTypeJRE}); err != nil {
+		t.Fatalf("Failed to write metadata: %v", err)
+	}
+	if !manager.isValidJDK(installPath) {
+		t.Fatal("Runtime recorded as jre should be valid")
+	}
+
+	versions, err := manager.ListInstalled()
+	if err != nil || len(versions) != 1 || versions[0] != "21-jre" {
+		t.Fatalf("Expected 21-jre to be listed, got %v (%v)", versions, err)
+	}
+
+	metadata, err := manager.GetMetadata("21-jre")
+	if err != nil || !metadata.IsRuntime() {
+		t.Fatalf("Expected jre metadata, got %+v (%v)", metadata, err)
+	}
+
+	sourcesPath := filepath.Join(manager.jdksDir, "21-sources")
+	if err := os.MkdirAll(filepath.Join(sourcesPath, "src"), 0755); err != nil {
+		t.Fatalf("Failed to create sources: %v", err)
+	}
+	if err := writeMetadata(sourcesPath, &Metadata{ImageType: adoptium.ImageTypeSources}); err != nil {
+		t.Fatalf("Failed to write metadata: %v", err)
+	}
+	if !manager.isValidJDK(sourcesPath) {
+		t.Fatal("Sources install should be valid without executables")
+	}
+
+	emptyPath := filepath.Join(manager.jdksDir, "21-debugimage")
+	if err := os.MkdirAll(emptyPath, 0755); err != nil {
+		t.Fatalf("Failed to create debug image: %v", err)
+	}
+	if err := writeMetadata(emptyPath, &Metadata{ImageType: adoptium.ImageTypeDebugImage}); err != nil {
+		t.Fatalf("Failed to write metadata: %v", err)
+	}
+	if manager.isValidJDK(emptyPath) {
+		t.Fatal("Install with nothing but a manifest should not be valid")
+	}
+}
+
+func TestGetInstallation_CorruptManifest(t *testing.T) {
+	manager := &Manager{jdksDir: t.TempDir(), platform: adoptium.CurrentPlatform()}
+
+	java, javac := "java", "javac"
+	if runtime.GOOS == "windows" {
+		java, javac = "java.exe", "javac.exe"
+	}
+	for _, name := range []string{"17", "21"} {
+		binDir := filepath.Join(manager.jdksDir, name, "bin")
+		if err := os.MkdirAll(binDir, 0755); err != nil {
+			t.Fatalf("Failed to create bin directory: %v", err)
+		}
+		for _, executable := range []string{java, javac} {
+			if err := os.WriteFile(filepath.Join(binDir, executable), nil, 0755); err != nil {
+				t.Fatalf("Failed to create %s: %v", executable, err)
+			}
+		}
+	}
+	if err := os.WriteFile(filepath.Join(manager.jdksDir, "21", MetadataFile), []byte("{not json"), 0644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	if installation, err := manager.GetInstallation("21"); err == nil {
+		t.Errorf("Expected an error for a corrupt manifest, got %+v", installation)
+	}
+
+	// The listing warns about the broken install and still shows the others
+	installations, err := manager.Installations()
+	if err != nil || len(installations) != 1 || installations[0].Name != "17" {
+		t.Errorf("Expected only 17 to be listed, got %v (%v)", installations, err)
+	}
+}
+
+func TestIsValidJDK_TargetPlatform(t *testing.T) {
+	createJDK := func(dir string, binDir string, executables ...string) {
+		if err := os.MkdirAll(filepath.Join(dir, binDir), 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", binDir, err)
+		}
+		for _, name := range executables {
+			if err := os.WriteFile(filepath.Join(dir, binDir, name), nil, 0755); err != nil {
+				t.Fatalf("Failed to create %s: %v", name, err)
+			}
+		}
+	}
+
+	windows := &Manager{jdksDir: t.TempDir(), platform: adoptium.Platform{OS: "windows", Arch: "x64"}}
+	createJDK(filepath.Join(windows.jdksDir, "21"), "bin", "java.exe", "javac.exe")
+	createJDK(filepath.Join(windows.jdksDir, "17"), "bin", "java", "javac")
+
+	versions, err := windows.ListInstalled()
+	if err != nil || len(versions) != 1 || versions[0] != "21" {
+		t.Fatalf("Expected only the Windows build to be valid, got %v (%v)", versions, err)
+	}
+
+	mac := &Manager{jdksDir: t.TempDir(), platform: adoptium.Platform{OS: "mac", Arch: "aarch64"}}
+	createJDK(filepath.Join(mac.jdksDir, "21"), filepath.Join("Contents", "Home", "bin"), "java", "javac")
+	if !mac.isValidJDK(filepath.Join(mac.jdksDir, "21")) {
+		t.Fatal("Expected a macOS bundle layout to be valid")
+	}
+	javaHome, err := mac.GetJDKPath("21")
+	if err != nil || javaHome != filepath.Join(mac.jdksDir, "21", "Contents", "Home") {
+		t.Errorf("Expected the bundle's Contents/Home as Java home, got %q (%v)", javaHome, err)
+	}
+	if javaHome, _ := windows.GetJDKPath("21"); javaHome != filepath.Join(windows.jdksDir, "21") {
+		t.Errorf("Expected the install itself as Java home, got %q", javaHome)
+	}
+
+	dest := filepath.Join(t.TempDir(), "bundle")
+	if err := windows.Export("21", dest); err != nil {
+		t.Fatalf("Failed to export: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "bin", "java.exe")); err != nil {
+		t.Errorf("Expected exported java.exe: %v", err)
+	}
+	if err := windows.Export("17", filepath.Join(t.TempDir(), "bundle")); err == nil {
+		t.Error("Expected error exporting an invalid install")
+	}
+}
+
+func TestIsGraalVM(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "jdk-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	if isGraalVM(tempDir) {
+		t.Fatal("Empty directory should not be detected as GraalVM")
+	}
+
+	if err := os.MkdirAll(filepath.Join(tempDir, "lib", "svm"), 0755); err != nil {
+		t.Fatalf("Failed to create lib/svm: %v", err)
+	}
+
+	if !isGraalVM(tempDir) {
+		t.Fatal("Directory with lib/svm should be detected as GraalVM")
+	}
+}
+
+func TestInstall_ChecksumMismatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("not the archive you are looking for"))
+	}))
+	defer server.Close()
+
+	manager := &Manager{jdksDir: t.TempDir()}
+
+	err := manager.Install(context.Background(), "21", &adoptium.DownloadInfo{
+		URL:      server.URL + "/jdk.tar.gz",
+		Filename: "jdk.tar.gz",
+		Checksum: strings.Repeat("0", 64),
+	}, false)
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("Expected checksum mismatch error, got %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(manager.jdksDir, "21")); !os.IsNotExist(err) {
+		t.Fatal("Nothing should be installed after a checksum mismatch")
+	}
+}
+
+type rejectingVerifier struct{}
+
+func (rejectingVerifier) Verify(filePath, signaturePath string) error {
+	return fmt.Errorf("bad signature")
+}
+
+func TestInstall_SignatureRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("content of " + r.URL.Path))
+	}))
+	defer server.Close()
+
+	manager := &Manager{jdksDir: t.TempDir()}
+	manager.SetSignatureVerifier(rejectingVerifier{})
+
+	err := manager.Install(context.Background(), "21", &adoptium.DownloadInfo{
+		URL:          server.URL + "/jdk.tar.gz",
+		Filename:     "jdk.tar.gz",
+		SignatureURL: server.URL + "/jdk.tar.gz.sig",
+	}, false)
+	if err == nil || !strings.Contains(err.Error(), "bad signature") {
+		t.Fatalf("Expected signature error, got %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(manager.jdksDir, "21")); !os.IsNotExist(err) {
+		t.Fatal("Nothing should be installed after a signature failure")
+	}
+}
+
+func TestInstall_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "1000000")
+		w.Write([]byte("partial archive"))
+		w.(http.Flusher).Flush()
+		cancel()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	manager := &Manager{jdksDir: t.TempDir()}
+	err := manager.Install(ctx, "21", &adoptium.DownloadInfo{
+		URL:      server.URL + "/jdk.tar.gz",
+		Filename: "jdk.tar.gz",
+	}, false)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected cancellation error, got %v", err)
+	}
+
+	// Install stages its download in a hidden directory of the store
+	staged, _ := filepath.Glob(filepath.Join(manager.jdksDir, stagingPrefix+"*"))
+	if len(staged) != 0 {
+		t.Errorf("Expected the staging directory to be removed, found %v", staged)
+	}
+	if _, err := os.Stat(filepath.Join(manager.jdksDir, "21")); !os.IsNotExist(err) {
+		t.Fatal("Nothing should be installed after cancellation")
+	}
+}
+
+// jdkArchive builds a tar.gz archive of a minimal JDK below a top-level directory
+func jdkArchive(t *testing.T, topDir string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	java, javac := "java", "javac"
+	if runtime.GOOS == "windows" {
+		java, javac = "java.exe", "javac.exe"
+	}
+	for _, name := range []string{java, javac} {
+		content := []byte("#!/bin/sh\n")
+		header := &tar.Header{Name: topDir + "/bin/" + name, Mode: 0755, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatalf("Failed to write tar header: %v", err)
+		}
+		if _, err := tw.Write(content); err != nil {
+			t.Fatalf("Failed to write tar content: %v", err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatalf("Failed to close tar writer: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("Failed to close gzip writer: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestInstall_WritesManifest(t *testing.T) {
+	archive := jdkArchive(t, "jdk-21.0.2+13")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archive)
+	}))
+	defer server.Close()
+
+	manager := &Manager{jdksDir: t.TempDir(), platform: adoptium.CurrentPlatform()}
+	err := manager.Install(context.Background(), "21", &adoptium.DownloadInfo{
+		URL:       server.URL + "/jdk.tar.gz",
+		Filename:  "jdk.tar.gz",
+		Vendor:    "temurin",
+		Version:   adoptium.VersionData{Major: 21, Security: 2, Build: 13},
+		ImageType: adoptium.ImageTypeJDK,
+		Platform:  adoptium.CurrentPlatform(),
+	}, false)
+	if err != nil {
+		t.Fatalf("Install failed: %v", err)
+	}
+
+	installation, err := manager.GetInstallation("21")
+	if err != nil || installation == nil {
+		t.Fatalf("Expected 21 to be installed, got %v (%v)", installation, err)
+	}
+
+	sum := sha256.Sum256(archive)
+	md := installation.Metadata
+	if md.Vendor != "temurin" || md.Version != "21.0.2+13" || md.Build != 13 || md.ImageType != adoptium.ImageTypeJDK {
+		t.Errorf("Unexpected release in manifest: %+v", md)
+	}
+	if md.URL != server.URL+"/jdk.tar.gz" || md.Size != int64(len(archive)) || md.Checksum != hex.EncodeToString(sum[:]) {
+		t.Errorf("Unexpected archive details in manifest: %+v", md)
+	}
+	if md.Platform != adoptium.CurrentPlatform() || md.InstalledAt.IsZero() {
+		t.Errorf("Unexpected platform or install time in manifest: %+v", md)
+	}
+
+	if v, err := installation.Version(); err != nil || v.String() != "21.0.2+13" {
+		t.Errorf("Expected the exact version from the manifest, got %v (%v)", v, err)
+	}
+}
+
+func TestInstall_Reinstall(t *testing.T) {
+	archive := jdkArchive(t, "jdk-21.0.2+13")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/broken.tar.gz" {
+			w.Write([]byte("not an archive"))
+			return
+		}
+		w.Write(archive)
+	}))
+	defer server.Close()
+
+	manager := &Manager{jdksDir: t.TempDir(), platform: adoptium.CurrentPlatform()}
+	install := func(build int, filename string, size int64) error {
+		return manager.Install(context.Background(), "21", &adoptium.DownloadInfo{
+			URL:      server.URL + "/" + filename,
+			Filename: filename,
+			Size:     size,
+			Vendor:   "temurin",
+			Version:  adoptium.VersionData{Major: 21, Security: 2, Build: build},
+		}, true)
+	}
+	installedBuild := func() int {
+		t.Helper()
+		md, err := manager.GetMetadata("21")
+		if err != nil {
+			t.Fatalf("Failed to read manifest: %v", err)
+		}
+		return md.Build
+	}
+
+	if err := install(13, "jdk.tar.gz", 0); err != nil {
+		t.Fatalf("Install failed: %v", err)
+	}
+
+	// Without force an existing install is never replaced, e.g. by a second
+	// process that waited for the first to finish installing the same version
+	err := manager.Install(context.Background(), "21", &adoptium.DownloadInfo{URL: server.URL + "/broken.tar.gz", Filename: "broken.tar.gz"}, false)
+	if !errors.Is(err, ErrAlreadyInstalled) || installedBuild() != 13 {
+		t.Errorf("Expected build 13 to be kept as already installed, got build %d (%v)", installedBuild(), err)
+	}
+
+	if err := install(14, "jdk.tar.gz", 0); err != nil || installedBuild() != 14 {
+		t.Fatalf("Expected the reinstall to replace build 13, got build %d (%v)", installedBuild(), err)
+	}
+
+	// Failed reinstalls leave the previous install in place
+	if err := install(15, "broken.tar.gz", 0); err == nil {
+		t.Error("Expected a broken archive to fail")
+	}
+	if err := install(15, "jdk.tar.gz", int64(len(archive))+1); err == nil {
+		t.Error("Expected a size mismatch to fail")
+	}
+	if installed, err := manager.IsInstalled("21"); !installed || err != nil || installedBuild() != 14 {
+		t.Errorf("Expected build 14 to still be installed, got %v (%v)", installed, err)
+	}
+
+	entries, err := os.ReadDir(manager.jdksDir)
+	if err != nil {
+		t.Fatalf("Failed to read store: %v", err)
+	}
+	for _, entry := range entries {
+		if entry.Name() != "21" && entry.Name() != LocksDir {
+			t.Errorf("Expected no staged or previous installs to be left, got %s", entry.Name())
+		}
+	}
+}
+
+func TestRecoverInstall(t *testing.T) {
+	manager := &Manager{jdksDir: t.TempDir()}
+	installPath := filepath.Join(manager.jdksDir, "21")
+	backupPath := filepath.Join(manager.jdksDir, backupPrefix+"21")
+
+	// Interrupted after moving the previous install aside
+	if err := os.MkdirAll(backupPath, 0755); err != nil {
+		t.Fatalf("Failed to create previous install: %v", err)
+	}
+	if err := manager.recoverInstall("21"); err != nil {
+		t.Fatalf("recoverInstall failed: %v", err)
+	}
+	if _, err := os.Stat(installPath); err != nil {
+		t.Errorf("Expected the previous install to be restored: %v", err)
+	}
+
+	// Interrupted after moving the new install in
+	if err := os.MkdirAll(backupPath, 0755); err != nil {
+		t.Fatalf("Failed to create previous install: %v", err)
+	}
+	if err := manager.recoverInstall("21"); err != nil {
+		t.Fatalf("recoverInstall failed: %v", err)
+	}
+	if _, err := os.Stat(backupPath); !os.IsNotExist(err) {
+		t.Errorf("Expected the previous install to be removed, got %v", err)
+	}
+	if _, err := os.Stat(installPath); err != nil {
+		t.Errorf("Expected the new install to be kept: %v", err)
+	}
+
+	// Staging directories of killed installs are removed, those of other versions kept
+	for _, name := range []string{stagingPrefix + "123-21", stagingPrefix + "456-21-jre"} {
+		if err := os.MkdirAll(filepath.Join(manager.jdksDir, name), 0755); err != nil {
+			t.Fatalf("Failed to create staging directory: %v", err)
+		}
+	}
+	if err := manager.recoverInstall("21"); err != nil {
+		t.Fatalf("recoverInstall failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(manager.jdksDir, stagingPrefix+"123-21")); !os.IsNotExist(err) {
+		t.Errorf("Expected the staging directory of 21 to be removed, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(manager.jdksDir, stagingPrefix+"456-21-jre")); err != nil {
+		t.Errorf("Expected the staging directory of 21-jre to be kept: %v", err)
+	}
+}
+
+func TestInstall_Locked(t *testing.T) {
+	manager := &Manager{jdksDir: t.TempDir(), platform: adoptium.CurrentPlatform()}
+	if err := os.MkdirAll(filepath.Join(manager.jdksDir, "17", "bin"), 0755); err != nil {
+		t.Fatalf("Failed to create install: %v", err)
+	}
+	for _, name := range []string{"java", "javac"} {
+		if runtime.GOOS == "windows" {
+			name += ".exe"
+		}
+		if err := os.WriteFile(filepath.Join(manager.jdksDir, "17", "bin", name), nil, 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+	}
+
+	// A lock of a process on another host is never taken over
+	lockDir := filepath.Join(manager.jdksDir, LocksDir)
+	if err := os.MkdirAll(lockDir, 0755); err != nil {
+		t.Fatalf("Failed to create lock directory: %v", err)
+	}
+	owner := `{"pid": 1, "host": "another-host", "acquired_at": "2024-01-16T10:00:00Z"}`
+	if err := os.WriteFile(filepath.Join(lockDir, "17.lock"), []byte(owner), 0644); err != nil {
+		t.Fatalf("Failed to write lock file: %v", err)
+	}
+
+	err := manager.Install(context.Background(), "17", &adoptium.DownloadInfo{URL: "http://127.0.0.1:0/jdk.tar.gz", Filename: "jdk.tar.gz"}, false)
+	if !errors.Is(err, lock.ErrTimeout) {
+		t.Errorf("Expected install of a locked version to time out, got %v", err)
+	}
+	if err := manager.Uninstall(context.Background(), "17"); !errors.Is(err, lock.ErrTimeout) {
+		t.Errorf("Expected uninstall of a locked version to time out, got %v", err)
+	}
+
+	// Listing does not take locks
+	installations, err := manager.Installations()
+	if err != nil || len(installations) != 1 || installations[0].Name != "17" {
+		t.Errorf("Expected 17 to be listed while locked, got %v (%v)", installations, err)
+	}
+}
@@ -0,0 +1,112 @@
+package jdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/version"
+)
+
+// MetadataFile is the name of the file recording how an installation was obtained
+const MetadataFile = ".jdk-manager.json"
+
+// Metadata is the manifest stored in the root of every installation. Installations
+// made before the manifest recorded the release only carry the image type and platform.
+type Metadata struct {
+	Vendor            string    `json:"vendor,omitempty"`  // Distribution name, e.g. temurin
+	Version           string    `json:"version,omitempty"` // Exact version, e.g. 21.0.2+13
+	Build             int       `json:"build,omitempty"`   // Build number of the release
+	ImageType         string    `json:"image_type"`        // jdk, jre, debugimage, staticlibs or sources
+	adoptium.Platform           // Platform the build was made for
+	URL               string    `json:"url,omitempty"`      // Where the archive was downloaded from
+	Checksum          string    `json:"checksum,omitempty"` // SHA-256 of the archive
+	Size              int64     `json:"size,omitempty"`     // Size of the archive in bytes
+	InstalledAt       time.Time `json:"installed_at"`       // When the installation was made
+}
+
+// Installation is an installed version together with its manifest and release file
+type Installation struct {
+	Name     string // Directory name, e.g. 21 or corretto-17-jre
+	Path     string
+	Metadata *Metadata
+	Release  *Release // nil if the installation has no readable release file
+
+	// ReleaseError is why the release file could not be read, if it could not
+	ReleaseError error
+}
+
+// Version returns the exact version recorded in the manifest, or in the release file
+// for installations made before it was recorded or not made by jdk-manager, falling
+// back to the version in the directory name
+func (i *Installation) Version() (version.Version, error) {
+	if i.Metadata != nil && i.Metadata.Version != "" {
+		return version.Parse(i.Metadata.Version)
+	}
+	if i.Release != nil {
+		if v, err := i.Release.Version(); err == nil {
+			return v, nil
+		}
+	}
+	_, v, _ := ParseInstallName(i.Name)
+	return version.Parse(v)
+}
+
+// Vendor returns the name of the distribution the installation came from
+func (i *Installation) Vendor() string {
+	if i.Metadata != nil && i.Metadata.Vendor != "" {
+		return i.Metadata.Vendor
+	}
+	if i.Release != nil && i.Release.Implementor != "" {
+		return i.Release.Vendor()
+	}
+	if vendor, _, _ := ParseInstallName(i.Name); vendor != "" {
+		return vendor
+	}
+	return DefaultVendor
+}
+
+// IsRuntime reports whether the installation contains a Java runtime that can be activated
+func (md *Metadata) IsRuntime() bool {
+	return md.ImageType == adoptium.ImageTypeJDK || md.ImageType == adoptium.ImageTypeJRE
+}
+
+// readMetadata reads the metadata of an installation. Installations made before
+// metadata was recorded carry no file and are treated as full JDKs.
+func readMetadata(installPath string) (*Metadata, error) {
+	md := &Metadata{ImageType: adoptium.ImageTypeJDK}
+
+	data, err := os.ReadFile(filepath.Join(installPath, MetadataFile))
+	if os.IsNotExist(err) {
+		return md, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read install metadata: %w", err)
+	}
+
+	if err := json.Unmarshal(data, md); err != nil {
+		return nil, fmt.Errorf("failed to parse install metadata %s: %w", filepath.Join(installPath, MetadataFile), err)
+	}
+	if md.ImageType == "" {
+		md.ImageType = adoptium.ImageTypeJDK
+	}
+
+	return md, nil
+}
+
+// writeMetadata stores the metadata of an installation
+func writeMetadata(installPath string, md *Metadata) error {
+	data, err := json.MarshalIndent(md, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode install metadata: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(installPath, MetadataFile), data, 0644); err != nil {
+		return fmt.Errorf("failed to write install metadata: %w", err)
+	}
+
+	return nil
+}
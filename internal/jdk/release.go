@@ -0,0 +1,146 @@
+package jdk
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/version"
+)
+
+// ReleaseFile is the properties file every JDK since Java 8 ships in its root
+const ReleaseFile = "release"
+
+// Release holds the properties of a JDK's release file
+type Release struct {
+	JavaVersion        string            // JAVA_VERSION, e.g. 21.0.2 or 1.8.0_392
+	RuntimeVersion     string            // JAVA_RUNTIME_VERSION, e.g. 21.0.2+13-LTS
+	Implementor        string            // IMPLEMENTOR, e.g. Eclipse Adoptium
+	ImplementorVersion string            // IMPLEMENTOR_VERSION, e.g. Temurin-21.0.2+13
+	OSName             string            // OS_NAME, e.g. Linux, Darwin or Windows
+	OSArch             string            // OS_ARCH, e.g. x86_64 or aarch64
+	LibC               string            // LIBC, musl for Alpine builds
+	Modules            []string          // MODULES, empty for Java 8
+	Properties         map[string]string // Every property in the file
+}
+
+// implementorVendors maps IMPLEMENTOR values to vendor names
+var implementorVendors = map[string]string{
+	"Eclipse Adoptium":   "temurin",
+	"Amazon.com Inc.":    "corretto",
+	"Azul Systems, Inc.": "zulu",
+	"GraalVM Community":  "graalvm",
+	"SAP SE":             "sap_machine",
+	"BellSoft":           "liberica",
+	"Microsoft":          "microsoft",
+	"Alibaba":            "dragonwell",
+	"AdoptOpenJDK":       "temurin",
+}
+
+// ParseRelease parses the KEY="value" lines of a release file
+func ParseRelease(r io.Reader) (*Release, error) {
+	properties := make(map[string]string)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+			value = value[1 : len(value)-1]
+		}
+		properties[strings.TrimSpace(key)] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read release file: %w", err)
+	}
+
+	release := &Release{
+		JavaVersion:        properties["JAVA_VERSION"],
+		RuntimeVersion:     properties["JAVA_RUNTIME_VERSION"],
+		Implementor:        properties["IMPLEMENTOR"],
+		ImplementorVersion: properties["IMPLEMENTOR_VERSION"],
+		OSName:             properties["OS_NAME"],
+		OSArch:             properties["OS_ARCH"],
+		LibC:               properties["LIBC"],
+		Modules:            strings.Fields(properties["MODULES"]),
+		Properties:         properties,
+	}
+	if release.JavaVersion == "" {
+		return nil, fmt.Errorf("release file has no JAVA_VERSION")
+	}
+
+	return release, nil
+}
+
+// ReadRelease reads the release file in the Java home of an installation. It
+// returns nil without error if there is none.
+func ReadRelease(installPath string) (*Release, error) {
+	dir := JavaHome(installPath)
+
+	file, err := os.Open(filepath.Join(dir, ReleaseFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open release file: %w", err)
+	}
+	defer file.Close()
+
+	release, err := ParseRelease(file)
+	if err != nil {
+		return nil, fmt.Errorf("invalid release file in %s: %w", dir, err)
+	}
+	return release, nil
+}
+
+// Version returns the full runtime version, e.g. 21.0.2+13, falling back to JAVA_VERSION
+func (r *Release) Version() (version.Version, error) {
+	if r.RuntimeVersion != "" {
+		if v, err := version.Parse(r.RuntimeVersion); err == nil {
+			return v, nil
+		}
+	}
+	return version.Parse(r.JavaVersion)
+}
+
+// Vendor returns the vendor name of the implementor, e.g. temurin for Eclipse Adoptium,
+// or the implementor itself if it is not a known distribution
+func (r *Release) Vendor() string {
+	if vendor, ok := implementorVendors[r.Implementor]; ok {
+		return vendor
+	}
+	return r.Implementor
+}
+
+// Platform returns the platform the JDK was built for, if the release file records it
+func (r *Release) Platform() (adoptium.Platform, bool) {
+	osName, err := adoptium.ParseOS(r.OSName)
+	if err != nil {
+		return adoptium.Platform{}, false
+	}
+	arch, err := adoptium.ParseArch(r.OSArch)
+	if err != nil {
+		return adoptium.Platform{}, false
+	}
+
+	platform := adoptium.Platform{OS: osName, Arch: arch}
+	if osName == "linux" {
+		platform.LibC = adoptium.LibCGlibc
+		if r.LibC == adoptium.LibCMusl {
+			platform.LibC = adoptium.LibCMusl
+		}
+	}
+	return platform, true
+}
@@ -0,0 +1,148 @@
+package jdk
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jdk-manager/internal/adoptium"
+)
+
+const temurinRelease = `IMPLEMENTOR="Eclipse Adoptium"
+IMPLEMENTOR_VERSION="Temurin-21.0.2+13"
+JAVA_RUNTIME_VERSION="21.0.2+13-LTS"
+JAVA_VERSION="21.0.2"
+JAVA_VERSION_DATE="2024-01-16"
+LIBC="musl"
+MODULES="java.base java.compiler java.datatransfer"
+OS_ARCH="x86_64"
+OS_NAME="Linux"
+SOURCE=".:git:5c1e1c1b2e3f"
+`
+
+const java8Release = `JAVA_VERSION="1.8.0_392"
+OS_NAME="Darwin"
+OS_ARCH="aarch64"
+SOURCE=" .:git:2d1a1e2f3c4b"
+IMPLEMENTOR="Amazon.com Inc."
+`
+
+func TestParseRelease(t *testing.T) {
+	release, err := ParseRelease(strings.NewReader(temurinRelease))
+	if err != nil {
+		t.Fatalf("Failed to parse release file: %v", err)
+	}
+
+	if release.Implementor != "Eclipse Adoptium" || release.Vendor() != "temurin" {
+		t.Errorf("Unexpected implementor %q (vendor %q)", release.Implementor, release.Vendor())
+	}
+	if v, err := release.Version(); err != nil || v.String() != "21.0.2+13" {
+		t.Errorf("Expected runtime version 21.0.2+13, got %v (%v)", v, err)
+	}
+	if strings.Join(release.Modules, " ") != "java.base java.compiler java.datatransfer" {
+		t.Errorf("Unexpected modules %v", release.Modules)
+	}
+	if release.Properties["JAVA_VERSION_DATE"] != "2024-01-16" {
+		t.Errorf("Expected all properties to be kept, got %v", release.Properties)
+	}
+
+	platform, ok := release.Platform()
+	if !ok || platform != (adoptium.Platform{OS: "linux", Arch: "x64", LibC: adoptium.LibCMusl}) {
+		t.Errorf("Unexpected platform %v (%v)", platform, ok)
+	}
+}
+
+func TestParseRelease_Java8(t *testing.T) {
+	release, err := ParseRelease(strings.NewReader(java8Release))
+	if err != nil {
+		t.Fatalf("Failed to parse release file: %v", err)
+	}
+
+	if v, err := release.Version(); err != nil || v.String() != "8.0.392" {
+		t.Errorf("Expected version 8.0.392, got %v (%v)", v, err)
+	}
+	if release.Vendor() != "corretto" || len(release.Modules) != 0 {
+		t.Errorf("Unexpected vendor %q or modules %v", release.Vendor(), release.Modules)
+	}
+	if platform, ok := release.Platform(); !ok || platform != (adoptium.Platform{OS: "mac", Arch: "aarch64"}) {
+		t.Errorf("Unexpected platform %v (%v)", platform, ok)
+	}
+}
+
+func TestParseRelease_MissingVersion(t *testing.T) {
+	if _, err := ParseRelease(strings.NewReader(`IMPLEMENTOR="Somebody"`)); err == nil {
+		t.Error("Expected error for a release file without JAVA_VERSION")
+	}
+}
+
+func TestReadRelease(t *testing.T) {
+	installPath := t.TempDir()
+	if release, err := ReadRelease(installPath); release != nil || err != nil {
+		t.Fatalf("Expected no release file, got %v (%v)", release, err)
+	}
+
+	// macOS bundles keep the release file in Contents/Home
+	home := filepath.Join(installPath, "Contents", "Home")
+	if err := os.MkdirAll(home, 0755); err != nil {
+		t.Fatalf("Failed to create bundle: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(home, ReleaseFile), []byte(java8Release), 0644); err != nil {
+		t.Fatalf("Failed to write release file: %v", err)
+	}
+
+	release, err := ReadRelease(installPath)
+	if err != nil || release == nil || release.JavaVersion != "1.8.0_392" {
+		t.Fatalf("Expected the bundle's release file, got %v (%v)", release, err)
+	}
+}
+
+func TestIsValidJDK_ReleaseFile(t *testing.T) {
+	manager := &Manager{jdksDir: t.TempDir(), platform: adoptium.Platform{OS: "linux", Arch: "x64"}}
+	installPath := filepath.Join(manager.jdksDir, "my-jdk")
+
+	for _, name := range []string{"java", "javac"} {
+		if err := os.MkdirAll(filepath.Join(installPath, "bin"), 0755); err != nil {
+			t.Fatalf("Failed to create bin directory: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(installPath, "bin", name), nil, 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+	}
+
+	writeRelease := func(content string) {
+		if err := os.WriteFile(filepath.Join(installPath, ReleaseFile), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write release file: %v", err)
+		}
+	}
+
+	// A JDK copied in by hand is identified from its release file
+	writeRelease(temurinRelease)
+	installation, err := manager.GetInstallation("my-jdk")
+	if err != nil || installation == nil {
+		t.Fatalf("Expected a valid install, got %v (%v)", installation, err)
+	}
+	if v, err := installation.Version(); err != nil || v.String() != "21.0.2+13" || installation.Vendor() != "temurin" {
+		t.Errorf("Expected temurin 21.0.2+13 from the release file, got %s %v (%v)", installation.Vendor(), v, err)
+	}
+
+	writeRelease(strings.Replace(temurinRelease, `OS_ARCH="x86_64"`, `OS_ARCH="aarch64"`, 1))
+	if manager.isValidJDK(installPath) {
+		t.Error("A build for another architecture should not be valid")
+	}
+
+	// An unreadable release file is reported, but the install is still usable
+	writeRelease(`IMPLEMENTOR="Eclipse Adoptium"`)
+	installation, err = manager.GetInstallation("my-jdk")
+	if err != nil || installation == nil {
+		t.Fatalf("Expected an install with an unreadable release file to be valid, got %v (%v)", installation, err)
+	}
+	if installation.Release != nil || installation.ReleaseError == nil {
+		t.Errorf("Expected the release file error to be reported, got %+v", installation)
+	}
+
+	os.Remove(filepath.Join(installPath, ReleaseFile))
+	if !manager.isValidJDK(installPath) {
+		t.Error("An install without a release file should still be valid")
+	}
+}
@@ -0,0 +1,229 @@
+// Package lock coordinates jdk-manager processes that change the same JDK store.
+// Lock files record the process holding them, so locks left behind by a process
+// that died are detected and taken over.
+package lock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"time"
+)
+
+// ErrTimeout is returned when a lock is not released within the timeout
+var ErrTimeout = errors.New("timed out waiting for lock")
+
+const (
+	// storeLockName is the lock guarding the other lock files of a store
+	storeLockName = ".store"
+
+	// The store lock is only held for a moment, so it is retried quickly
+	storeLockRetry    = 10 * time.Millisecond
+	storeLockAttempts = 500
+
+	// A store lock that has no owner yet is only broken once it is this old,
+	// its holder may still be writing it
+	storeLockGrace = time.Second
+)
+
+// Owner describes the process holding a lock
+type Owner struct {
+	PID        int       `json:"pid"`
+	Host       string    `json:"host"`
+	AcquiredAt time.Time `json:"acquired_at"`
+}
+
+// String returns a description such as "process 1234 on build-01 (since 15:04:05)"
+func (o Owner) String() string {
+	return fmt.Sprintf("process %d on %s (since %s)", o.PID, o.Host, o.AcquiredAt.Local().Format("15:04:05"))
+}
+
+// alive reports whether the owning process still runs. Processes on other hosts,
+// e.g. sharing the store over NFS, cannot be checked and are assumed to run.
+func (o Owner) alive() bool {
+	if host, err := os.Hostname(); err != nil || o.Host != host {
+		return true
+	}
+	return processAlive(o.PID)
+}
+
+// Lock is a lock held by this process
+type Lock struct {
+	path string
+}
+
+// Release removes the lock file
+func (l *Lock) Release() error {
+	if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to release lock: %w", err)
+	}
+	return nil
+}
+
+// Store manages the lock files in a directory. Locks are taken per name, e.g. per
+// installed version, and held for a whole operation. A store lock, held only while a
+// lock is taken, makes taking over the lock of a dead process safe.
+type Store struct {
+	dir          string
+	pollInterval time.Duration
+}
+
+// NewStore creates a store for the lock files in dir
+func NewStore(dir string) *Store {
+	return &Store{dir: dir, pollInterval: 100 * time.Millisecond}
+}
+
+// Lock takes the lock for name. While another running process holds it, Lock waits up
+// to timeout for it to be released; with a timeout of 0 it fails right away. waiting,
+// if not nil, is called with the holder before waiting starts.
+func (s *Store) Lock(ctx context.Context, name string, timeout time.Duration, waiting func(Owner)) (*Lock, error) {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create lock directory: %w", err)
+	}
+
+	path := filepath.Join(s.dir, name+".lock")
+	deadline := time.Now().Add(timeout)
+	for notified := false; ; notified = true {
+		owner, err := s.tryLock(path)
+		if err != nil {
+			return nil, err
+		}
+		if owner == nil {
+			return &Lock{path: path}, nil
+		}
+
+		if !time.Now().Before(deadline) {
+			return nil, fmt.Errorf("%w %s: held by %s", ErrTimeout, name, owner)
+		}
+		if !notified && waiting != nil {
+			waiting(*owner)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+// tryLock takes the lock at path unless a running process holds it, in which case
+// that process is returned. Locks of dead processes and unreadable locks, which can
+// only be left by a process that died while writing them, are taken over.
+func (s *Store) tryLock(path string) (*Owner, error) {
+	storeLock, err := s.lockStore()
+	if err != nil {
+		return nil, err
+	}
+	defer storeLock.Release()
+
+	owner, err := readOwner(path)
+	if err == nil && owner.alive() {
+		return owner, nil
+	}
+	if err != nil && !os.IsNotExist(err) && !errors.Is(err, errInvalidOwner) {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create lock file: %w", err)
+	}
+	return nil, writeOwner(file)
+}
+
+// lockStore takes the store lock. A store lock left by a dead process is broken.
+func (s *Store) lockStore() (*Lock, error) {
+	path := filepath.Join(s.dir, storeLockName+".lock")
+
+	for attempt := 1; ; attempt++ {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			if err := writeOwner(file); err != nil {
+				os.Remove(path)
+				return nil, err
+			}
+			return &Lock{path: path}, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("failed to create lock file: %w", err)
+		}
+
+		owner, err := readOwner(path)
+		switch {
+		case err == nil && !owner.alive():
+			os.Remove(path)
+			continue
+		case errors.Is(err, errInvalidOwner):
+			if info, statErr := os.Stat(path); statErr == nil && time.Since(info.ModTime()) > storeLockGrace {
+				os.Remove(path)
+				continue
+			}
+		}
+
+		if attempt >= storeLockAttempts {
+			if owner != nil {
+				return nil, fmt.Errorf("lock file %s is held by %s", path, owner)
+			}
+			return nil, fmt.Errorf("lock file %s is held by another process", path)
+		}
+		time.Sleep(storeLockRetry)
+	}
+}
+
+// errInvalidOwner is returned for lock files that do not name their owner
+var errInvalidOwner = errors.New("invalid lock file")
+
+// readOwner reads the owner recorded in a lock file
+func readOwner(path string) (*Owner, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var owner Owner
+	if err := json.Unmarshal(data, &owner); err != nil || owner.PID <= 0 {
+		return nil, fmt.Errorf("%w: %s", errInvalidOwner, path)
+	}
+	return &owner, nil
+}
+
+// writeOwner records this process as the owner of an opened lock file and closes it
+func writeOwner(file *os.File) error {
+	host, _ := os.Hostname()
+	owner := Owner{PID: os.Getpid(), Host: host, AcquiredAt: time.Now().UTC().Truncate(time.Second)}
+
+	data, err := json.Marshal(owner)
+	if err == nil {
+		_, err = file.Write(data)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write lock file: %w", err)
+	}
+	return nil
+}
+
+// processAlive reports whether a process with the given PID runs on this host
+func processAlive(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		// On Windows finding a process fails once it has exited
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		process.Release()
+		return true
+	}
+
+	// Signal 0 only checks for existence; EPERM means it runs as another user
+	err = process.Signal(syscall.Signal(0))
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
@@ -0,0 +1,143 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func newTestStore(t *testing.T) *Store {
+	store := NewStore(filepath.Join(t.TempDir(), "locks"))
+	store.pollInterval = 5 * time.Millisecond
+	return store
+}
+
+// deadPID returns the PID of a process that has exited
+func deadPID(t *testing.T) int {
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("Failed to run process: %v", err)
+	}
+	return cmd.Process.Pid
+}
+
+func writeLockFile(t *testing.T, store *Store, name, content string) {
+	if err := os.MkdirAll(store.dir, 0755); err != nil {
+		t.Fatalf("Failed to create lock directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(store.dir, name+".lock"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write lock file: %v", err)
+	}
+}
+
+func TestLock(t *testing.T) {
+	store := newTestStore(t)
+
+	held, err := store.Lock(context.Background(), "21", 0, nil)
+	if err != nil {
+		t.Fatalf("Failed to take lock: %v", err)
+	}
+
+	// Other names are not blocked
+	other, err := store.Lock(context.Background(), "17", 0, nil)
+	if err != nil {
+		t.Fatalf("Failed to take lock of another name: %v", err)
+	}
+	other.Release()
+
+	var holder Owner
+	_, err = store.Lock(context.Background(), "21", 20*time.Millisecond, func(owner Owner) { holder = owner })
+	if !errors.Is(err, ErrTimeout) {
+		t.Errorf("Expected a held lock to time out, got %v", err)
+	}
+	if holder.PID != os.Getpid() {
+		t.Errorf("Expected to wait for this process, got %+v", holder)
+	}
+
+	if err := held.Release(); err != nil {
+		t.Fatalf("Failed to release lock: %v", err)
+	}
+	again, err := store.Lock(context.Background(), "21", 0, nil)
+	if err != nil {
+		t.Fatalf("Failed to take released lock: %v", err)
+	}
+	again.Release()
+
+	if _, err := os.Stat(filepath.Join(store.dir, storeLockName+".lock")); !os.IsNotExist(err) {
+		t.Errorf("Expected the store lock to be released, got %v", err)
+	}
+}
+
+func TestLock_Waits(t *testing.T) {
+	store := newTestStore(t)
+
+	held, err := store.Lock(context.Background(), "21", 0, nil)
+	if err != nil {
+		t.Fatalf("Failed to take lock: %v", err)
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		held.Release()
+	}()
+
+	waited := false
+	lock, err := store.Lock(context.Background(), "21", 5*time.Second, func(Owner) { waited = true })
+	if err != nil {
+		t.Fatalf("Expected the lock once it is released, got %v", err)
+	}
+	lock.Release()
+	if !waited {
+		t.Error("Expected to be told about the wait")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	blocking, err := store.Lock(context.Background(), "21", 0, nil)
+	if err != nil {
+		t.Fatalf("Failed to take lock: %v", err)
+	}
+	defer blocking.Release()
+	cancel()
+	if _, err := store.Lock(ctx, "21", time.Minute, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected waiting to stop when cancelled, got %v", err)
+	}
+}
+
+func TestLock_Stale(t *testing.T) {
+	host, err := os.Hostname()
+	if err != nil {
+		t.Skipf("No host name: %v", err)
+	}
+	store := newTestStore(t)
+
+	// Locks of dead processes and unreadable locks are taken over
+	dead := `{"pid": ` + strconv.Itoa(deadPID(t)) + `, "host": "` + host + `"}`
+	writeLockFile(t, store, "21", dead)
+	writeLockFile(t, store, "17", `{"pid": `)
+	for _, name := range []string{"21", "17"} {
+		lock, err := store.Lock(context.Background(), name, 0, nil)
+		if err != nil {
+			t.Errorf("Expected stale lock %s to be taken over, got %v", name, err)
+			continue
+		}
+		lock.Release()
+	}
+
+	// The same goes for the store lock
+	writeLockFile(t, store, storeLockName, dead)
+	lock, err := store.Lock(context.Background(), "21", 0, nil)
+	if err != nil {
+		t.Fatalf("Expected stale store lock to be broken, got %v", err)
+	}
+	lock.Release()
+
+	// Processes on other hosts cannot be checked
+	writeLockFile(t, store, "21", `{"pid": 1, "host": "another-host"}`)
+	if _, err := store.Lock(context.Background(), "21", 0, nil); !errors.Is(err, ErrTimeout) {
+		t.Errorf("Expected a lock from another host to be respected, got %v", err)
+	}
+}
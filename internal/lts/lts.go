@@ -0,0 +1,37 @@
+package lts
+
+import (
+	"context"
+	"fmt"
+)
+
+// KnownReleases is used when the list can neither be fetched nor read from the cache.
+// It is deliberately not extrapolated: a release is only marked LTS once an API says so.
+var KnownReleases = []int{8, 11, 17, 21, 25}
+
+// Source returns the major versions a distribution lists as long-term support releases.
+// Sources fetch through the metadata cache, which decides when the list is refreshed.
+type Source interface {
+	GetLTSReleases(ctx context.Context) ([]int, error)
+}
+
+// Releases returns the LTS major versions reported by a source. When neither the
+// source nor its cache can answer, KnownReleases is returned together with the error
+// so callers can warn and carry on.
+func Releases(ctx context.Context, source Source) ([]int, error) {
+	releases, err := source.GetLTSReleases(ctx)
+	if err != nil {
+		return KnownReleases, fmt.Errorf("failed to fetch LTS releases, using built-in list: %w", err)
+	}
+	return releases, nil
+}
+
+// IsLTS reports whether major is one of the given LTS releases
+func IsLTS(releases []int, major int) bool {
+	for _, lts := range releases {
+		if lts == major {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package lts
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeSource struct {
+	releases []int
+	err      error
+}
+
+func (s *fakeSource) GetLTSReleases(ctx context.Context) ([]int, error) {
+	return s.releases, s.err
+}
+
+func TestReleases(t *testing.T) {
+	releases, err := Releases(context.Background(), &fakeSource{releases: []int{8, 11, 17, 21, 25, 29}})
+	if err != nil {
+		t.Fatalf("Failed to resolve LTS releases: %v", err)
+	}
+	if !IsLTS(releases, 29) || IsLTS(releases, 24) {
+		t.Errorf("Expected the list of the source, got %v", releases)
+	}
+}
+
+func TestReleases_OfflineFallback(t *testing.T) {
+	releases, err := Releases(context.Background(), &fakeSource{err: errors.New("network unreachable")})
+	if err == nil {
+		t.Error("Expected the fetch error to be reported")
+	}
+	if len(releases) != len(KnownReleases) {
+		t.Errorf("Expected built-in list, got %v", releases)
+	}
+}
+
+func TestIsLTS(t *testing.T) {
+	tests := []struct {
+		version int
+		isLTS   bool
+	}{
+		{8, true},
+		{11, true},
+		{17, true},
+		{21, true},
+		{25, true},
+		{9, false},
+		{10, false},
+		{12, false},
+		{16, false},
+		{22, false},
+		{23, false},
+		{24, false},
+		{26, false},
+		{29, false}, // Not listed until an API reports it
+	}
+
+	for _, test := range tests {
+		result := IsLTS(KnownReleases, test.version)
+		if result != test.isLTS {
+			t.Errorf("IsLTS(%d) = %v, expected %v", test.version, result, test.isLTS)
+		}
+	}
+}
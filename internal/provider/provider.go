@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/jdk-manager/internal/adoptium"
+)
+
+// Provider is a source of JDK builds for a single distribution (Temurin, Corretto, ...)
+type Provider interface {
+	// Vendor returns metadata describing the distribution
+	Vendor() adoptium.Vendor
+	// GetAvailableReleases lists the releases offered by the distribution
+	GetAvailableReleases(ctx context.Context) ([]adoptium.Release, error)
+	// GetDownloadInfo resolves a version and image type to a downloadable archive for the requested platform
+	GetDownloadInfo(ctx context.Context, request adoptium.DownloadRequest) (*adoptium.DownloadInfo, error)
+}
+
+// PreReleaseProvider is implemented by providers that publish early-access builds,
+// installable with a version such as 25-ea
+type PreReleaseProvider interface {
+	GetPreReleases(ctx context.Context) ([]adoptium.Release, error)
+}
+
+// MajorReleaseProvider is implemented by providers that can list the releases of a
+// single major version without fetching those of every other one
+type MajorReleaseProvider interface {
+	GetMajorReleases(ctx context.Context, major int) ([]adoptium.Release, error)
+}
+
+// ReleaseProvider is implemented by providers that publish release details such as
+// the release date, release notes and the platforms a release is built for
+type ReleaseProvider interface {
+	GetRelease(ctx context.Context, request adoptium.DownloadRequest) (*adoptium.Release, error)
+}
+
+// HTTPClientSetter is implemented by providers whose API requests can be routed
+// through a different HTTP client, e.g. one that caches responses
+type HTTPClientSetter interface {
+	SetHTTPClient(client *http.Client)
+}
+
+// Registry holds the known providers, keyed by vendor name and aliases
+type Registry struct {
+	providers []Provider
+	byName    map[string]Provider
+}
+
+// NewRegistry creates a registry from the given providers.
+// The first provider becomes the default one.
+func NewRegistry(providers ...Provider) *Registry {
+	r := &Registry{
+		byName: make(map[string]Provider),
+	}
+
+	for _, p := range providers {
+		if err := r.Register(p); err != nil {
+			panic(err)
+		}
+	}
+
+	return r
+}
+
+// Register adds a provider to the registry
+func (r *Registry) Register(p Provider) error {
+	vendor := p.Vendor()
+	names := append([]string{vendor.Name}, vendor.Aliases...)
+
+	for _, name := range names {
+		key := normalizeName(name)
+		if _, exists := r.byName[key]; exists {
+			return fmt.Errorf("provider %q is already registered", name)
+		}
+	}
+
+	for _, name := range names {
+		r.byName[normalizeName(name)] = p
+	}
+	r.providers = append(r.providers, p)
+
+	return nil
+}
+
+// Get returns the provider registered under the given vendor name or alias.
+// An empty name selects the default provider.
+func (r *Registry) Get(name string) (Provider, error) {
+	if name == "" {
+		if len(r.providers) == 0 {
+			return nil, fmt.Errorf("no providers registered")
+		}
+		return r.providers[0], nil
+	}
+
+	p, ok := r.byName[normalizeName(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown vendor %q (available: %s)", name, strings.Join(r.Names(), ", "))
+	}
+
+	return p, nil
+}
+
+// Names returns the primary vendor names of all registered providers, sorted
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for _, p := range r.providers {
+		names = append(names, p.Vendor().Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Providers returns all registered providers in registration order
+func (r *Registry) Providers() []Provider {
+	return append([]Provider(nil), r.providers...)
+}
+
+// normalizeName makes vendor lookups case-insensitive and tolerant of '-' vs '_'
+func normalizeName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), "-", "_")
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jdk-manager/internal/adoptium"
+)
+
+type fakeProvider struct {
+	vendor adoptium.Vendor
+}
+
+func (f *fakeProvider) Vendor() adoptium.Vendor { return f.vendor }
+
+func (f *fakeProvider) GetAvailableReleases(ctx context.Context) ([]adoptium.Release, error) {
+	return nil, nil
+}
+
+func (f *fakeProvider) GetDownloadInfo(ctx context.Context, request adoptium.DownloadRequest) (*adoptium.DownloadInfo, error) {
+	return nil, nil
+}
+
+func TestRegistryGet(t *testing.T) {
+	temurin := &fakeProvider{vendor: adoptium.Vendor{Name: "temurin", Aliases: []string{"adoptium"}}}
+	other := &fakeProvider{vendor: adoptium.Vendor{Name: "sap_machine"}}
+	registry := NewRegistry(temurin, other)
+
+	tests := []struct {
+		name     string
+		expected Provider
+	}{
+		{"", temurin},
+		{"temurin", temurin},
+		{"Adoptium", temurin},
+		{"sap_machine", other},
+		{"sap-machine", other},
+	}
+
+	for _, test := range tests {
+		p, err := registry.Get(test.name)
+		if err != nil {
+			t.Errorf("Unexpected error for vendor %q: %v", test.name, err)
+			continue
+		}
+		if p != test.expected {
+			t.Errorf("Wrong provider returned for vendor %q", test.name)
+		}
+	}
+
+	if _, err := registry.Get("unknown"); err == nil {
+		t.Fatal("Expected error for unknown vendor")
+	}
+}
+
+func TestRegistryRegisterDuplicate(t *testing.T) {
+	registry := NewRegistry(&fakeProvider{vendor: adoptium.Vendor{Name: "temurin"}})
+
+	err := registry.Register(&fakeProvider{vendor: adoptium.Vendor{Name: "other", Aliases: []string{"Temurin"}}})
+	if err == nil {
+		t.Fatal("Expected error when registering a duplicate vendor name")
+	}
+
+	if names := registry.Names(); len(names) != 1 || names[0] != "temurin" {
+		t.Fatalf("Expected only temurin to be registered, got %v", names)
+	}
+}
+
+func TestAdoptiumClientIsProvider(t *testing.T) {
+	var p Provider = adoptium.NewClient()
+	if p.Vendor().Name != "temurin" {
+		t.Fatalf("Expected vendor temurin, got %s", p.Vendor().Name)
+	}
+
+	if _, ok := p.(HTTPClientSetter); !ok {
+		t.Fatal("Expected the Adoptium client to accept a custom HTTP client")
+	}
+}
@@ -0,0 +1,262 @@
+// Package query resolves version queries such as ">=17 <21", "17.x", "~17.0.8",
+// "latest" or "lts" against a list of available or installed versions.
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jdk-manager/internal/lts"
+	"github.com/jdk-manager/internal/version"
+)
+
+// Keywords selecting the newest GA release, or the newest LTS release
+const (
+	Latest    = "latest"
+	LTS       = "lts"
+	LatestLTS = "latest-lts"
+)
+
+// Query is a parsed version query. All of its constraints must hold for a version to match.
+type Query struct {
+	raw         string
+	constraints []constraint
+	ltsOnly     bool
+	earlyAccess bool
+	exact       bool
+}
+
+// constraint compares a version, truncated to the precision of bound, with bound
+type constraint struct {
+	op    string
+	bound version.Version
+}
+
+// operators are checked in order, so two character operators come first
+var operators = []string{">=", "<=", ">", "<", "="}
+
+// Parse parses a version query. Supported forms are plain versions (21, 17.0.8, 25-ea, 8u392),
+// wildcards (17.x, 17.0.*), tilde ranges (~17.0.8), comparisons separated by spaces
+// (>=17 <21) and the keywords latest, lts and latest-lts.
+func Parse(s string) (*Query, error) {
+	raw := strings.TrimSpace(s)
+	q := &Query{raw: raw}
+
+	switch strings.ToLower(raw) {
+	case "":
+		return nil, fmt.Errorf("empty version query")
+	case Latest:
+		return q, nil
+	case LTS, LatestLTS:
+		q.ltsOnly = true
+		return q, nil
+	}
+
+	fields := strings.Fields(raw)
+	for _, field := range fields {
+		constraints, err := parseTerm(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version query %q: %w", raw, err)
+		}
+		q.constraints = append(q.constraints, constraints...)
+	}
+
+	// Only a lone version may ask for early-access builds, e.g. 25-ea. It is passed
+	// to the distribution as it is.
+	if len(fields) == 1 && len(q.constraints) == 1 && q.constraints[0].op == "" {
+		q.exact = true
+		q.earlyAccess = q.constraints[0].bound.IsPreRelease()
+	}
+	for _, c := range q.constraints {
+		if c.bound.IsPreRelease() && !q.exact {
+			return nil, fmt.Errorf("invalid version query %q: early-access builds can only be requested by version, e.g. 25-ea", raw)
+		}
+	}
+
+	return q, nil
+}
+
+// parseTerm parses a single space separated term of a query
+func parseTerm(term string) ([]constraint, error) {
+	if rest, ok := strings.CutPrefix(term, "~"); ok {
+		bound, err := parseBound(rest)
+		if err != nil {
+			return nil, err
+		}
+		// ~17.0.8 allows later security updates of 17.0, ~17 any 17 release
+		next := fmt.Sprintf("%d", bound.Major+1)
+		if bound.Precision() > 1 {
+			next = fmt.Sprintf("%d.%d", bound.Major, bound.Minor+1)
+		}
+		upper, err := version.Parse(next)
+		if err != nil {
+			return nil, err
+		}
+		return []constraint{{">=", bound}, {"<", upper}}, nil
+	}
+
+	for _, op := range operators {
+		if rest, ok := strings.CutPrefix(term, op); ok {
+			bound, err := parseBound(rest)
+			if err != nil {
+				return nil, err
+			}
+			return []constraint{{op, bound}}, nil
+		}
+	}
+
+	// 17.x and 17.0.* match every release with that prefix, like a plain 17 or 17.0
+	parts := strings.Split(term, ".")
+	wildcard := false
+	for len(parts) > 1 && isWildcard(parts[len(parts)-1]) {
+		parts = parts[:len(parts)-1]
+		wildcard = true
+	}
+	bound, err := parseBound(strings.Join(parts, "."))
+	if err != nil {
+		return nil, err
+	}
+	if wildcard {
+		return []constraint{{"=", bound}}, nil
+	}
+	return []constraint{{"", bound}}, nil
+}
+
+// parseBound parses a version in a query. Pre-releases other than early-access builds are not supported.
+func parseBound(s string) (version.Version, error) {
+	v, err := version.Parse(s)
+	if err != nil {
+		return v, err
+	}
+	if v.IsPreRelease() && v.Pre != "ea" {
+		return v, fmt.Errorf("unsupported pre-release %q", v.Pre)
+	}
+	return v, nil
+}
+
+func isWildcard(part string) bool {
+	return part == "x" || part == "X" || part == "*"
+}
+
+// String returns the query as it was given
+func (q *Query) String() string {
+	return q.raw
+}
+
+// IsExact reports whether the query is a plain version like 21, 17.0.8 or 25-ea,
+// which distributions resolve themselves
+func (q *Query) IsExact() bool {
+	return q.exact
+}
+
+// Version returns the version of an exact query
+func (q *Query) Version() version.Version {
+	if !q.exact {
+		return version.Version{}
+	}
+	return q.constraints[0].bound
+}
+
+// Major returns the major version all matching versions share, or 0 if matches
+// may belong to more than one, as for >=17 or lts
+func (q *Query) Major() int {
+	low, high := 0, 0
+	for _, c := range q.constraints {
+		bound := c.bound
+		// Versions compare by the precision of the bound, so <18 excludes 18.0.1
+		// while <17.1 still allows 17.0.8
+		beyondMajor := bound.Minor > 0 || bound.Security > 0 || bound.Patch > 0
+
+		switch c.op {
+		case ">=":
+			low = max(low, bound.Major)
+		case ">":
+			if beyondMajor {
+				low = max(low, bound.Major)
+			} else {
+				low = max(low, bound.Major+1)
+			}
+		case "<=":
+			high = minBound(high, bound.Major)
+		case "<":
+			if beyondMajor {
+				high = minBound(high, bound.Major)
+			} else {
+				high = minBound(high, bound.Major-1)
+			}
+		default: // "", "="
+			low = max(low, bound.Major)
+			high = minBound(high, bound.Major)
+		}
+	}
+
+	if low > 0 && low == high {
+		return low
+	}
+	return 0
+}
+
+// minBound returns the lower of two upper bounds, 0 meaning unbounded
+func minBound(bound, major int) int {
+	if bound == 0 {
+		return major
+	}
+	return min(bound, major)
+}
+
+// NeedsLTS reports whether matching depends on the list of LTS releases
+func (q *Query) NeedsLTS() bool {
+	return q.ltsOnly
+}
+
+// Matches reports whether v satisfies the query. Early-access builds only match
+// queries that ask for them with -ea.
+func (q *Query) Matches(v version.Version, ltsReleases []int) bool {
+	if v.IsPreRelease() != q.earlyAccess {
+		return false
+	}
+	if q.ltsOnly && !lts.IsLTS(ltsReleases, v.Major) {
+		return false
+	}
+
+	for _, c := range q.constraints {
+		if !c.matches(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Select returns the index of the newest candidate matching the query, the first
+// one on ties, or -1 if none matches
+func (q *Query) Select(candidates []version.Version, ltsReleases []int) int {
+	best := -1
+	for i, candidate := range candidates {
+		if !q.Matches(candidate, ltsReleases) {
+			continue
+		}
+		if best < 0 || candidate.Compare(candidates[best]) > 0 {
+			best = i
+		}
+	}
+	return best
+}
+
+func (c constraint) matches(v version.Version) bool {
+	result := v.ComparePrefix(c.bound)
+	switch c.op {
+	case ">=":
+		return result >= 0
+	case "<=":
+		return result <= 0
+	case ">":
+		return result > 0
+	case "<":
+		return result < 0
+	case "=":
+		return result == 0
+	default:
+		// A plain version also pins the build if one is given, e.g. 21.0.2+13
+		return v.HasPrefix(c.bound)
+	}
+}
@@ -0,0 +1,177 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/jdk-manager/internal/version"
+)
+
+func parseVersion(t *testing.T, s string) version.Version {
+	t.Helper()
+	v, err := version.Parse(s)
+	if err != nil {
+		t.Fatalf("Failed to parse version %s: %v", s, err)
+	}
+	return v
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, s := range []string{"", "  ", "invalid", "v21", "21-beta", "-ea", ">=", "~", "17.y", ">=17 <x", "1.2.3.4.5", "17.-1", ">=25-ea", "17.x-ea"} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q) should fail", s)
+		}
+	}
+}
+
+func TestIsExact(t *testing.T) {
+	tests := []struct {
+		query string
+		exact bool
+	}{
+		{"21", true},
+		{"17.0.8", true},
+		{"25-ea", true},
+		{"17.x", false},
+		{"~17.0.8", false},
+		{">=17", false},
+		{"latest", false},
+		{"lts", false},
+	}
+
+	for _, test := range tests {
+		q, err := Parse(test.query)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", test.query, err)
+		}
+		if q.IsExact() != test.exact {
+			t.Errorf("Parse(%q).IsExact() = %v, expected %v", test.query, q.IsExact(), test.exact)
+		}
+	}
+}
+
+func TestMajor(t *testing.T) {
+	tests := []struct {
+		query string
+		major int
+	}{
+		{"21", 21},
+		{"17.0.8", 17},
+		{"17.x", 17},
+		{"~17.0.8", 17},
+		{"~17", 17},
+		{">=17 <18", 17},
+		{">=17.0.2 <17.1", 17},
+		{">16 <=17", 17},
+		{">=17 <21", 0},
+		{">=17", 0},
+		{"latest", 0},
+		{"lts", 0},
+	}
+
+	for _, test := range tests {
+		q, err := Parse(test.query)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", test.query, err)
+		}
+		if major := q.Major(); major != test.major {
+			t.Errorf("Parse(%q).Major() = %d, expected %d", test.query, major, test.major)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	ltsReleases := []int{8, 11, 17, 21, 25}
+
+	tests := []struct {
+		query    string
+		version  string
+		expected bool
+	}{
+		{"17", "17.0.8", true},
+		{"17", "21.0.1", false},
+		{"17.0.8", "17.0.8", true},
+		{"17.0.8", "17.0.9", false},
+		{"17.x", "17.0.12", true},
+		{"17.X", "18", false},
+		{"17.0.*", "17.0.3", true},
+		{"17.0.x", "17.1.0", false},
+		{">=17 <21", "17", true},
+		{">=17 <21", "20.0.2", true},
+		{">=17 <21", "21", false},
+		{">=17 <21", "11.0.20", false},
+		{">17", "17.0.8", false},
+		{">17", "18", true},
+		{">17.0.8", "17.0.9", true},
+		{"<=17", "17.0.12", true},
+		{"=21", "21.0.2", true},
+		{"~17.0.8", "17.0.8", true},
+		{"~17.0.8", "17.0.12", true},
+		{"~17.0.8", "17.0.7", false},
+		{"~17.0.8", "17.1.0", false},
+		{"~17", "17.5", true},
+		{"~17", "18", false},
+		{"latest", "24.0.1", true},
+		{"latest", "25-ea", false},
+		{"lts", "21.0.2", true},
+		{"latest-lts", "24", false},
+		{"25-ea", "25-ea", true},
+		{"25-ea", "25", false},
+		{"25", "25-ea", false},
+		{"8u392", "8.0.392", true},
+		{"1.8.0_392", "8.0.392", true},
+		{">=8u392 <11", "8.0.402", true},
+		{"21.0.2+13", "21.0.2+13", true},
+		{"21.0.2+13", "21.0.2+12", false},
+	}
+
+	for _, test := range tests {
+		q, err := Parse(test.query)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", test.query, err)
+		}
+		if result := q.Matches(parseVersion(t, test.version), ltsReleases); result != test.expected {
+			t.Errorf("%q matches %s = %v, expected %v", test.query, test.version, result, test.expected)
+		}
+	}
+}
+
+func TestSelect(t *testing.T) {
+	ltsReleases := []int{8, 11, 17, 21, 25}
+
+	var candidates []version.Version
+	for _, s := range []string{"11.0.20", "17.0.8", "17.0.12", "21.0.2", "24", "25-ea"} {
+		candidates = append(candidates, parseVersion(t, s))
+	}
+
+	tests := []struct {
+		query    string
+		expected int
+	}{
+		{"latest", 4},
+		{"lts", 3},
+		{"latest-lts", 3},
+		{">=17 <21", 2},
+		{"17.x", 2},
+		{"~17.0.8", 2},
+		{"<17", 0},
+		{"25-ea", 5},
+		{"22", -1},
+	}
+
+	for _, test := range tests {
+		q, err := Parse(test.query)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", test.query, err)
+		}
+		if result := q.Select(candidates, ltsReleases); result != test.expected {
+			t.Errorf("Select(%q) = %d, expected %d", test.query, result, test.expected)
+		}
+	}
+
+	// Equal versions, e.g. the same release from two vendors, resolve to the first
+	tied := []version.Version{parseVersion(t, "21"), parseVersion(t, "21")}
+	q, _ := Parse("21")
+	if result := q.Select(tied, nil); result != 0 {
+		t.Errorf("Expected the first of equal versions, got %d", result)
+	}
+}
@@ -0,0 +1,189 @@
+package signature
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/jdk-manager/internal/httpclient"
+)
+
+const (
+	// AdoptiumKeyFingerprint is the pinned fingerprint of the key Adoptium signs Temurin archives with
+	AdoptiumKeyFingerprint = "3B04D753C9050D9A5D343F39843C48A565F8F04B"
+
+	adoptiumKeyURL  = "https://keyserver.ubuntu.com/pks/lookup?op=get&options=mr&search=0x" + AdoptiumKeyFingerprint
+	adoptiumKeyFile = "adoptium.asc"
+)
+
+// Verifier checks detached OpenPGP signatures against a trusted keyring
+type Verifier struct {
+	keyring openpgp.EntityList
+}
+
+// NewVerifier creates a verifier trusting the given keys
+func NewVerifier(keyring openpgp.EntityList) *Verifier {
+	return &Verifier{keyring: keyring}
+}
+
+// LoadVerifier creates a verifier trusting the pinned Adoptium key and any extra key files.
+// An extra key file holding the Adoptium key is used as is. Otherwise the key is read from
+// keyDir, or fetched from keyURL (the Ubuntu keyserver if empty) and cached there; either
+// way its fingerprint must match AdoptiumKeyFingerprint.
+func LoadVerifier(ctx context.Context, keyDir, keyURL string, extraKeyFiles []string) (*Verifier, error) {
+	if keyURL == "" {
+		keyURL = adoptiumKeyURL
+	}
+	return loadVerifier(ctx, keyDir, keyURL, AdoptiumKeyFingerprint, extraKeyFiles)
+}
+
+// loadVerifier builds the keyring for LoadVerifier, pinning the given fingerprint
+func loadVerifier(ctx context.Context, keyDir, keyURL, fingerprint string, extraKeyFiles []string) (*Verifier, error) {
+	var keyring openpgp.EntityList
+	for _, keyFile := range extraKeyFiles {
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read signature key %s: %w", keyFile, err)
+		}
+
+		keys, err := readKeyRing(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse signature key %s: %w", keyFile, err)
+		}
+		keyring = append(keyring, keys...)
+	}
+
+	for _, entity := range keyring {
+		if matchesFingerprint(entity, fingerprint) {
+			return NewVerifier(keyring), nil
+		}
+	}
+
+	cachePath := filepath.Join(keyDir, adoptiumKeyFile)
+	pinned, err := loadPinnedKey(ctx, cachePath, keyURL, fingerprint)
+	if err != nil {
+		return nil, fmt.Errorf("%w (place the Adoptium key in %s, list it under signature_keys or set signature_key_url)", err, cachePath)
+	}
+
+	return NewVerifier(append(pinned, keyring...)), nil
+}
+
+// Verify checks that signaturePath holds a valid detached signature of filePath
+// made by one of the trusted keys. Both binary and ASCII armored signatures are accepted.
+func (v *Verifier) Verify(filePath, signaturePath string) error {
+	signature, err := os.ReadFile(signaturePath)
+	if err != nil {
+		return fmt.Errorf("failed to read signature: %w", err)
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to open signed file: %w", err)
+	}
+	defer file.Close()
+
+	if isArmored(signature) {
+		_, err = openpgp.CheckArmoredDetachedSignature(v.keyring, file, bytes.NewReader(signature), nil)
+	} else {
+		_, err = openpgp.CheckDetachedSignature(v.keyring, file, bytes.NewReader(signature), nil)
+	}
+	if err != nil {
+		return fmt.Errorf("signature verification failed: %w", err)
+	}
+
+	return nil
+}
+
+// loadPinnedKey reads a cached key, downloading it first if needed, and checks its fingerprint
+func loadPinnedKey(ctx context.Context, cachePath, keyURL, fingerprint string) (openpgp.EntityList, error) {
+	data, err := os.ReadFile(cachePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read cached key: %w", err)
+		}
+
+		data, err = fetchKey(ctx, keyURL)
+		if err != nil {
+			return nil, err
+		}
+
+		// Only cache a key that passed the fingerprint check
+		if _, err := parsePinnedKey(data, fingerprint); err != nil {
+			return nil, err
+		}
+		if err := os.MkdirAll(filepath.Dir(cachePath), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create key directory: %w", err)
+		}
+		if err := os.WriteFile(cachePath, data, 0644); err != nil {
+			return nil, fmt.Errorf("failed to cache key: %w", err)
+		}
+	}
+
+	return parsePinnedKey(data, fingerprint)
+}
+
+// parsePinnedKey parses a key and returns only the entity matching the pinned fingerprint
+func parsePinnedKey(data []byte, fingerprint string) (openpgp.EntityList, error) {
+	keys, err := readKeyRing(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key: %w", err)
+	}
+
+	for _, entity := range keys {
+		if matchesFingerprint(entity, fingerprint) {
+			return openpgp.EntityList{entity}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("key does not match pinned fingerprint %s", fingerprint)
+}
+
+// matchesFingerprint checks if the primary key of entity has the given fingerprint
+func matchesFingerprint(entity *openpgp.Entity, fingerprint string) bool {
+	return strings.EqualFold(hex.EncodeToString(entity.PrimaryKey.Fingerprint), fingerprint)
+}
+
+// fetchKey downloads an ASCII armored public key
+func fetchKey(ctx context.Context, keyURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, keyURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := httpclient.New().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch signing key: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("signing key download failed with status: %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+
+	return data, nil
+}
+
+// readKeyRing parses a binary or ASCII armored keyring
+func readKeyRing(data []byte) (openpgp.EntityList, error) {
+	if isArmored(data) {
+		return openpgp.ReadArmoredKeyRing(bytes.NewReader(data))
+	}
+	return openpgp.ReadKeyRing(bytes.NewReader(data))
+}
+
+// isArmored checks if data is ASCII armored
+func isArmored(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte("-----BEGIN PGP"))
+}
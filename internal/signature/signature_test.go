@@ -0,0 +1,155 @@
+package signature
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+)
+
+func newTestEntity(t *testing.T) *openpgp.Entity {
+	entity, err := openpgp.NewEntity("Test Signer", "", "signer@example.com", nil)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	return entity
+}
+
+func armoredPublicKey(t *testing.T, entity *openpgp.Entity) []byte {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatalf("Failed to armor key: %v", err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatalf("Failed to serialize key: %v", err)
+	}
+	w.Close()
+	return buf.Bytes()
+}
+
+func TestVerify(t *testing.T) {
+	signer := newTestEntity(t)
+	dir := t.TempDir()
+
+	archive := filepath.Join(dir, "jdk.tar.gz")
+	if err := os.WriteFile(archive, []byte("archive content"), 0644); err != nil {
+		t.Fatalf("Failed to write archive: %v", err)
+	}
+
+	var sig bytes.Buffer
+	if err := openpgp.DetachSign(&sig, signer, strings.NewReader("archive content"), nil); err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+	sigPath := filepath.Join(dir, "jdk.tar.gz.sig")
+	if err := os.WriteFile(sigPath, sig.Bytes(), 0644); err != nil {
+		t.Fatalf("Failed to write signature: %v", err)
+	}
+
+	if err := NewVerifier(openpgp.EntityList{signer}).Verify(archive, sigPath); err != nil {
+		t.Fatalf("Valid signature should verify: %v", err)
+	}
+
+	// A signature from an untrusted key must be rejected
+	if err := NewVerifier(openpgp.EntityList{newTestEntity(t)}).Verify(archive, sigPath); err == nil {
+		t.Fatal("Signature from an untrusted key should fail")
+	}
+
+	// A tampered archive must be rejected
+	if err := os.WriteFile(archive, []byte("tampered content"), 0644); err != nil {
+		t.Fatalf("Failed to write archive: %v", err)
+	}
+	if err := NewVerifier(openpgp.EntityList{signer}).Verify(archive, sigPath); err == nil {
+		t.Fatal("Signature of a modified file should fail")
+	}
+}
+
+func TestLoadPinnedKey(t *testing.T) {
+	entity := newTestEntity(t)
+	fingerprint := strings.ToUpper(hex.EncodeToString(entity.PrimaryKey.Fingerprint))
+	keyData := armoredPublicKey(t, entity)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write(keyData)
+	}))
+	defer server.Close()
+
+	cachePath := filepath.Join(t.TempDir(), "keys", "adoptium.asc")
+
+	keys, err := loadPinnedKey(context.Background(), cachePath, server.URL, fingerprint)
+	if err != nil {
+		t.Fatalf("Failed to load pinned key: %v", err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("Expected 1 key, got %d", len(keys))
+	}
+
+	// Second load is served from the cache
+	if _, err := loadPinnedKey(context.Background(), cachePath, server.URL, fingerprint); err != nil {
+		t.Fatalf("Failed to load cached key: %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("Expected the key to be fetched once, got %d requests", requests)
+	}
+
+	// A key with another fingerprint must not be trusted or cached
+	otherCache := filepath.Join(t.TempDir(), "adoptium.asc")
+	if _, err := loadPinnedKey(context.Background(), otherCache, server.URL, AdoptiumKeyFingerprint); err == nil {
+		t.Fatal("Expected error for a key not matching the pinned fingerprint")
+	}
+	if _, err := os.Stat(otherCache); !os.IsNotExist(err) {
+		t.Fatal("A key failing the fingerprint check should not be cached")
+	}
+}
+
+func TestLoadVerifier_ExtraPinnedKey(t *testing.T) {
+	entity := newTestEntity(t)
+	fingerprint := strings.ToUpper(hex.EncodeToString(entity.PrimaryKey.Fingerprint))
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	keyDir := filepath.Join(t.TempDir(), "keys")
+
+	// Without access to the key the error explains how to provide it
+	_, err := loadVerifier(context.Background(), keyDir, server.URL, fingerprint, nil)
+	if err == nil {
+		t.Fatal("Expected error when the pinned key cannot be fetched")
+	}
+	if !strings.Contains(err.Error(), "signature_keys") || !strings.Contains(err.Error(), "signature_key_url") {
+		t.Errorf("Error should say how to provide the key, got: %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("Expected the key to be fetched from the configured URL, got %d requests", requests)
+	}
+
+	// The pinned key listed as an extra key file is used without fetching
+	keyFile := filepath.Join(t.TempDir(), "adoptium.asc")
+	if err := os.WriteFile(keyFile, armoredPublicKey(t, entity), 0644); err != nil {
+		t.Fatalf("Failed to write key: %v", err)
+	}
+	verifier, err := loadVerifier(context.Background(), keyDir, server.URL, fingerprint, []string{keyFile})
+	if err != nil {
+		t.Fatalf("Failed to load verifier from extra key: %v", err)
+	}
+	if len(verifier.keyring) != 1 {
+		t.Errorf("Expected 1 trusted key, got %d", len(verifier.keyring))
+	}
+	if requests != 1 {
+		t.Errorf("Pinned key from signature_keys should not be fetched, got %d requests", requests)
+	}
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/jdk-manager/internal/httpclient"
+)
+
+// FileSHA256 returns the hex encoded SHA-256 digest of a file
+func FileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to hash file: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// VerifyFile checks a downloaded file against its expected size and SHA-256 checksum.
+// A zero size or empty checksum skips that check.
+func VerifyFile(path string, size int64, checksum string) error {
+	if size > 0 {
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("failed to stat file: %w", err)
+		}
+		if info.Size() != size {
+			return fmt.Errorf("size mismatch: expected %d bytes, got %d", size, info.Size())
+		}
+	}
+
+	if checksum == "" {
+		return nil
+	}
+
+	actual, err := FileSHA256(path)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(actual, checksum) {
+		return fmt.Errorf("checksum mismatch: expected SHA-256 %s, got %s", strings.ToLower(checksum), actual)
+	}
+
+	return nil
+}
+
+// FetchChecksum downloads a checksum file (as published next to release archives,
+// e.g. "<sha256>  <filename>") and returns the SHA-256 it contains
+func FetchChecksum(ctx context.Context, url string) (string, error) {
+	data, err := fetchSmallFile(ctx, url, 4096)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch checksum: %w", err)
+	}
+
+	return ParseChecksum(string(data))
+}
+
+// FetchSignature downloads a detached signature file
+func FetchSignature(ctx context.Context, url string) ([]byte, error) {
+	data, err := fetchSmallFile(ctx, url, 64*1024)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch signature: %w", err)
+	}
+
+	return data, nil
+}
+
+// fetchSmallFile downloads a small file into memory, without progress output
+func fetchSmallFile(ctx context.Context, url string, limit int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpclient.New().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download failed with status: %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, limit))
+}
+
+// ParseChecksum extracts the SHA-256 from the content of a checksum file
+func ParseChecksum(content string) (string, error) {
+	fields := strings.Fields(content)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("empty checksum file")
+	}
+
+	checksum := strings.ToLower(fields[0])
+	if _, err := hex.DecodeString(checksum); err != nil || len(checksum) != sha256.Size*2 {
+		return "", fmt.Errorf("invalid SHA-256 checksum: %s", fields[0])
+	}
+
+	return checksum, nil
+}
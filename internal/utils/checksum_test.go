@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// SHA-256 of "Hello, World!"
+const helloSHA256 = "dffd6021bb2bd5b0af676290809ec3a53191dd81c7f70a4b28688a362182986f"
+
+func TestVerifyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "archive.tar.gz")
+	if err := os.WriteFile(path, []byte("Hello, World!"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	tests := []struct {
+		size     int64
+		checksum string
+		hasError bool
+	}{
+		{0, "", false},
+		{13, helloSHA256, false},
+		{0, strings.ToUpper(helloSHA256), false},
+		{12, helloSHA256, true},
+		{13, strings.Repeat("0", 64), true},
+	}
+
+	for _, test := range tests {
+		err := VerifyFile(path, test.size, test.checksum)
+		if (err != nil) != test.hasError {
+			t.Errorf("VerifyFile(size=%d, checksum=%s) error = %v, expected error: %v", test.size, test.checksum, err, test.hasError)
+		}
+	}
+}
+
+func TestParseChecksum(t *testing.T) {
+	tests := []struct {
+		content  string
+		expected string
+		hasError bool
+	}{
+		{helloSHA256 + "  OpenJDK21U-jdk_x64_linux_hotspot.tar.gz\n", helloSHA256, false},
+		{strings.ToUpper(helloSHA256), helloSHA256, false},
+		{"", "", true},
+		{"not-a-checksum file.tar.gz", "", true},
+		{"abcd", "", true},
+	}
+
+	for _, test := range tests {
+		result, err := ParseChecksum(test.content)
+		if test.hasError {
+			if err == nil {
+				t.Errorf("Expected error for %q", test.content)
+			}
+			continue
+		}
+		if err != nil || result != test.expected {
+			t.Errorf("ParseChecksum(%q) = %s, %v", test.content, result, err)
+		}
+	}
+}
+
+func TestFetchChecksum(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s  archive.tar.gz\n", helloSHA256)
+	}))
+	defer server.Close()
+
+	checksum, err := FetchChecksum(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("Failed to fetch checksum: %v", err)
+	}
+	if checksum != helloSHA256 {
+		t.Fatalf("Expected %s, got %s", helloSHA256, checksum)
+	}
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestCopyDir(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "jdk-21")
+	if err := os.MkdirAll(filepath.Join(src, "bin"), 0755); err != nil {
+		t.Fatalf("Failed to create source tree: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "bin", "java"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatalf("Failed to create java: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(src, ".skip-me"), []byte("{}"), 0644); err != nil {
+		t.Fatalf("Failed to create skipped file: %v", err)
+	}
+	if runtime.GOOS != "windows" {
+		if err := os.Symlink("bin", filepath.Join(src, "commands")); err != nil {
+			t.Fatalf("Failed to create symlink: %v", err)
+		}
+	}
+
+	dst := filepath.Join(t.TempDir(), "bundle")
+	err := CopyDir(src, dst, func(rel string) bool { return rel == ".skip-me" })
+	if err != nil {
+		t.Fatalf("Failed to copy directory: %v", err)
+	}
+
+	info, err := os.Stat(filepath.Join(dst, "bin", "java"))
+	if err != nil {
+		t.Fatalf("Expected bin/java to be copied: %v", err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0100 == 0 {
+		t.Errorf("Expected bin/java to stay executable, got %v", info.Mode())
+	}
+
+	if _, err := os.Stat(filepath.Join(dst, ".skip-me")); !os.IsNotExist(err) {
+		t.Error("Expected skipped file to be left out")
+	}
+
+	if runtime.GOOS != "windows" {
+		if linkname, err := os.Readlink(filepath.Join(dst, "commands")); err != nil || linkname != "bin" {
+			t.Errorf("Expected symlink to bin, got %q (%v)", linkname, err)
+		}
+	}
+
+	if err := CopyDir(src, dst, nil); err == nil {
+		t.Error("Expected error when the destination exists")
+	}
+}
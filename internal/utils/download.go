@@ -1,30 +1,40 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/jdk-manager/internal/httpclient"
 	"github.com/schollz/progressbar/v3"
 )
 
-// DownloadFile downloads a file from the given URL and saves it to the specified path
-func DownloadFile(url, filepath string) error {
+// DownloadFile downloads a file from the given URL and saves it to the specified path.
+// Cancelling ctx aborts the transfer. The file is removed if the download fails.
+func DownloadFile(ctx context.Context, url, filepath string) (err error) {
 	// Create the file
 	out, err := os.Create(filepath)
 	if err != nil {
 		return fmt.Errorf("failed to create file: %w", err)
 	}
-	defer out.Close()
+	defer func() {
+		out.Close()
+		if err != nil {
+			os.Remove(filepath)
+		}
+	}()
 
-	// Get the data
-	client := &http.Client{
-		Timeout: 10 * time.Minute, // Long timeout for large files
+	// Get the data. There is no total timeout, only stalled transfers are aborted.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
 	}
-	
-	resp, err := client.Get(url)
+
+	resp, err := httpclient.New().Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to download file: %w", err)
 	}
@@ -58,3 +68,30 @@ func DownloadFile(url, filepath string) error {
 
 	return nil
 }
+
+// DownloadFileFromURLs downloads a file trying each URL in order until one succeeds
+func DownloadFileFromURLs(ctx context.Context, urls []string, filepath string) error {
+	if len(urls) == 0 {
+		return fmt.Errorf("no download URL given")
+	}
+
+	var failures []string
+	for i, url := range urls {
+		err := DownloadFile(ctx, url, filepath)
+		if err == nil {
+			return nil
+		}
+
+		// Other mirrors would fail the same way
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		failures = append(failures, fmt.Sprintf("%s: %v", url, err))
+		if i < len(urls)-1 {
+			fmt.Fprintf(os.Stderr, "Download from %s failed: %v\nTrying next mirror...\n", url, err)
+		}
+	}
+
+	return fmt.Errorf("all download locations failed:\n  %s", strings.Join(failures, "\n  "))
+}
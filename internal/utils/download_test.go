@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jdk-manager/internal/httpclient"
+)
+
+func TestMain(m *testing.M) {
+	// Keep retries of failing test servers fast
+	settings := httpclient.DefaultSettings()
+	settings.InitialBackoff = time.Millisecond
+	settings.MaxBackoff = 10 * time.Millisecond
+	httpclient.Configure(settings)
+
+	os.Exit(m.Run())
+}
+
+func TestDownloadFileFromURLs_FallsBack(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+
+	working := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("archive content"))
+	}))
+	defer working.Close()
+
+	target := filepath.Join(t.TempDir(), "jdk.tar.gz")
+	if err := DownloadFileFromURLs(context.Background(), []string{failing.URL + "/jdk.tar.gz", working.URL + "/jdk.tar.gz"}, target); err != nil {
+		t.Fatalf("Download should succeed from the second URL: %v", err)
+	}
+
+	content, err := os.ReadFile(target)
+	if err != nil {
+		t.Fatalf("Failed to read downloaded file: %v", err)
+	}
+	if string(content) != "archive content" {
+		t.Fatalf("Unexpected content: %s", string(content))
+	}
+}
+
+func TestDownloadFileFromURLs_AllFail(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer failing.Close()
+
+	target := filepath.Join(t.TempDir(), "jdk.tar.gz")
+	if err := DownloadFileFromURLs(context.Background(), []string{failing.URL + "/a", failing.URL + "/b"}, target); err == nil {
+		t.Fatal("Expected error when every URL fails")
+	}
+
+	if err := DownloadFileFromURLs(context.Background(), nil, target); err == nil {
+		t.Fatal("Expected error without URLs")
+	}
+}
+
+func TestDownloadFile_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "1000")
+		w.Write([]byte("partial"))
+		w.(http.Flusher).Flush()
+		cancel()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	target := filepath.Join(t.TempDir(), "jdk.tar.gz")
+	if err := DownloadFileFromURLs(ctx, []string{server.URL, server.URL}, target); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected cancellation error, got %v", err)
+	}
+
+	if _, err := os.Stat(target); !os.IsNotExist(err) {
+		t.Error("Expected the partial download to be removed")
+	}
+}
@@ -11,17 +11,52 @@ import (
 	"strings"
 )
 
-// ExtractArchive extracts a tar.gz or zip archive to the specified directory
+// ExtractArchive extracts a tar.gz or zip archive to the specified directory.
+// The format is taken from the file extension (case-insensitive) and, for names
+// without a known extension, from the archive's leading magic bytes.
 func ExtractArchive(archivePath, destDir string) (string, error) {
-	if strings.HasSuffix(archivePath, ".tar.gz") || strings.HasSuffix(archivePath, ".tgz") {
+	switch detectArchiveFormat(archivePath) {
+	case "tar.gz":
 		return extractTarGz(archivePath, destDir)
-	} else if strings.HasSuffix(archivePath, ".zip") {
+	case "zip":
 		return extractZip(archivePath, destDir)
 	}
 	
 	return "", fmt.Errorf("unsupported archive format: %s", archivePath)
 }
 
+// detectArchiveFormat returns "tar.gz", "zip" or "" for an archive path
+func detectArchiveFormat(archivePath string) string {
+	lower := strings.ToLower(archivePath)
+	switch {
+	case strings.HasSuffix(lower, ".tar.gz") || strings.HasSuffix(lower, ".tgz"):
+		return "tar.gz"
+	case strings.HasSuffix(lower, ".zip"):
+		return "zip"
+	}
+
+	// Fall back to sniffing the content, e.g. for download names without an extension
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(file, magic); err != nil {
+		return ""
+	}
+
+	switch {
+	case magic[0] == 0x1f && magic[1] == 0x8b:
+		return "tar.gz"
+	case string(magic) == "PK\x03\x04":
+		return "zip"
+	}
+
+	return ""
+}
+
 // extractTarGz extracts a tar.gz archive
 func extractTarGz(archivePath, destDir string) (string, error) {
 	file, err := os.Open(archivePath)
@@ -63,6 +98,10 @@ func extractTarGz(archivePath, destDir string) (string, error) {
 		if !strings.HasPrefix(target, filepath.Clean(destDir)+string(os.PathSeparator)) {
 			return "", fmt.Errorf("invalid file path: %s", header.Name)
 		}
+		// Symlinks extracted earlier must not lead the entry out of destDir either
+		if _, err := resolveInside(destDir, target); err != nil {
+			return "", fmt.Errorf("invalid file path: %s: %w", header.Name, err)
+		}
 
 		switch header.Typeflag {
 		case tar.TypeDir:
@@ -73,6 +112,11 @@ func extractTarGz(archivePath, destDir string) (string, error) {
 			if err := extractTarFile(tr, target, os.FileMode(header.Mode)); err != nil {
 				return "", fmt.Errorf("failed to extract file %s: %w", header.Name, err)
 			}
+		case tar.TypeSymlink:
+			// Some distributions (e.g. Zulu on macOS) link bin/, lib/ etc. into a bundle
+			if err := extractTarSymlink(header.Linkname, target, destDir); err != nil {
+				return "", fmt.Errorf("failed to extract symlink %s: %w", header.Name, err)
+			}
 		}
 	}
 
@@ -135,7 +179,8 @@ func extractTarFile(tr *tar.Reader, target string, mode os.FileMode) error {
 		return err
 	}
 
-	f, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR, 0644)
+	// Keep the executable bits so that bin/java can be run
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR|os.O_TRUNC, mode.Perm()|0600)
 	if err != nil {
 		return err
 	}
@@ -145,6 +190,64 @@ func extractTarFile(tr *tar.Reader, target string, mode os.FileMode) error {
 	return err
 }
 
+// extractTarSymlink creates a symlink from a tar archive, refusing links that
+// point outside of destDir
+func extractTarSymlink(linkname, target, destDir string) error {
+	if filepath.IsAbs(linkname) {
+		return fmt.Errorf("absolute link target: %s", linkname)
+	}
+
+	// The link is resolved from the directory it really ends up in, which may
+	// be reached through links extracted earlier
+	parent, err := resolveInside(destDir, filepath.Dir(target))
+	if err != nil {
+		return err
+	}
+	if _, err := resolveInside(destDir, filepath.Join(parent, linkname)); err != nil {
+		return fmt.Errorf("link target outside archive: %s", linkname)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	return os.Symlink(linkname, target)
+}
+
+// resolveInside resolves the symlinks along path, of which only a leading part needs
+// to exist, and returns the result if it stays within destDir
+func resolveInside(destDir, path string) (string, error) {
+	root, err := filepath.EvalSymlinks(destDir)
+	if err != nil {
+		return "", err
+	}
+
+	// Resolve the deepest existing part, the rest will be created below it
+	existing, rest := filepath.Clean(path), ""
+	for {
+		if _, err := os.Lstat(existing); err == nil {
+			break
+		}
+		parent := filepath.Dir(existing)
+		if parent == existing {
+			break
+		}
+		rest = filepath.Join(filepath.Base(existing), rest)
+		existing = parent
+	}
+
+	resolved, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return "", err
+	}
+	resolved = filepath.Join(resolved, rest)
+
+	if resolved != root && !strings.HasPrefix(resolved, root+string(os.PathSeparator)) {
+		return "", fmt.Errorf("%s resolves outside %s", path, destDir)
+	}
+	return resolved, nil
+}
+
 // extractZipFile extracts a single file from a zip archive
 func extractZipFile(f *zip.File, target string) error {
 	// Create parent directories
@@ -112,6 +112,134 @@ func TestExtractZip(t *testing.T) {
 	}
 }
 
+func TestExtractArchive_DetectsFormatFromContent(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "extract-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	// Archives saved under names without a known extension
+	tarGzPath := filepath.Join(tempDir, "download-tgz")
+	if err := createTestTarGz(tarGzPath); err != nil {
+		t.Fatalf("Failed to create test tar.gz: %v", err)
+	}
+	zipPath := filepath.Join(tempDir, "ZULU-TEST.ZIP")
+	if err := createTestZip(zipPath); err != nil {
+		t.Fatalf("Failed to create test zip: %v", err)
+	}
+
+	for _, archivePath := range []string{tarGzPath, zipPath} {
+		destDir := filepath.Join(tempDir, "out-"+filepath.Base(archivePath))
+		if err := os.MkdirAll(destDir, 0755); err != nil {
+			t.Fatalf("Failed to create destination: %v", err)
+		}
+
+		extractedPath, err := ExtractArchive(archivePath, destDir)
+		if err != nil {
+			t.Fatalf("Failed to extract %s: %v", archivePath, err)
+		}
+
+		if _, err := os.Stat(filepath.Join(extractedPath, "test.txt")); err != nil {
+			t.Fatalf("Test file should exist after extracting %s", archivePath)
+		}
+	}
+}
+
+func TestExtractTarGz_Symlinks(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "extract-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	tarGzPath := filepath.Join(tempDir, "links.tar.gz")
+	err = createTarGz(tarGzPath, []*tar.Header{
+		{Name: "zulu/", Mode: 0755, Typeflag: tar.TypeDir},
+		{Name: "zulu/zulu-21.jdk/Contents/Home/bin/", Mode: 0755, Typeflag: tar.TypeDir},
+		{Name: "zulu/bin", Linkname: "zulu-21.jdk/Contents/Home/bin", Typeflag: tar.TypeSymlink},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test tar.gz: %v", err)
+	}
+
+	extractedPath, err := ExtractArchive(tarGzPath, tempDir)
+	if err != nil {
+		t.Fatalf("Failed to extract tar.gz: %v", err)
+	}
+
+	info, err := os.Stat(filepath.Join(extractedPath, "bin"))
+	if err != nil || !info.IsDir() {
+		t.Fatalf("Symlinked bin directory should resolve to a directory: %v", err)
+	}
+
+	// Links escaping the destination must be rejected
+	escapePath := filepath.Join(tempDir, "escape.tar.gz")
+	err = createTarGz(escapePath, []*tar.Header{
+		{Name: "evil/", Mode: 0755, Typeflag: tar.TypeDir},
+		{Name: "evil/link", Linkname: "../../etc", Typeflag: tar.TypeSymlink},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test tar.gz: %v", err)
+	}
+
+	if _, err := ExtractArchive(escapePath, tempDir); err == nil {
+		t.Fatal("Expected error for symlink pointing outside the destination")
+	}
+}
+
+func TestExtractTarGz_SymlinkChain(t *testing.T) {
+	tempDir := t.TempDir()
+	destDir := filepath.Join(tempDir, "dest")
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		t.Fatalf("Failed to create destination: %v", err)
+	}
+
+	// Each link stays inside the archive when read on its own, but l2 is created
+	// through l1 and l3 through both, so l3 points above the destination
+	chainPath := filepath.Join(tempDir, "chain.tar.gz")
+	err := createTarGz(chainPath, []*tar.Header{
+		{Name: "a/b/", Mode: 0755, Typeflag: tar.TypeDir},
+		{Name: "a/b/l1", Linkname: "..", Typeflag: tar.TypeSymlink},
+		{Name: "a/b/l1/l2", Linkname: "..", Typeflag: tar.TypeSymlink},
+		{Name: "a/b/l1/l2/l3", Linkname: "..", Typeflag: tar.TypeSymlink},
+		{Name: "a/b/l1/l2/l3/escaped.txt", Mode: 0644, Typeflag: tar.TypeReg},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test tar.gz: %v", err)
+	}
+
+	if _, err := ExtractArchive(chainPath, destDir); err == nil {
+		t.Fatal("Expected error for a symlink chain leading outside the destination")
+	}
+	if _, err := os.Lstat(filepath.Join(tempDir, "escaped.txt")); !os.IsNotExist(err) {
+		t.Error("No file may be written outside the destination")
+	}
+}
+
+// Helper function to create a tar.gz file containing only the given headers
+func createTarGz(path string, headers []*tar.Header) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gzWriter := gzip.NewWriter(file)
+	defer gzWriter.Close()
+
+	tarWriter := tar.NewWriter(gzWriter)
+	defer tarWriter.Close()
+
+	for _, header := range headers {
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Helper function to create a test tar.gz file
 func createTestTarGz(path string) error {
 	file, err := os.Create(path)
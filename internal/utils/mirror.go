@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// MirrorURLs returns the mirrored locations of rawURL, one per mirror, in order.
+//
+// A mirror is either a base URL to which the upstream path is appended
+// (https://nexus.example.com/repository/github) or a template containing the
+// placeholders {host}, {path} and {filename}
+// (https://artifactory.example.com/jdks/{filename}).
+func MirrorURLs(rawURL string, mirrors []string) ([]string, error) {
+	var urls []string
+
+	for _, mirror := range mirrors {
+		mirrored, err := RewriteURL(rawURL, mirror)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, mirrored)
+	}
+
+	return urls, nil
+}
+
+// RewriteURL maps an upstream download URL onto a single mirror
+func RewriteURL(rawURL, mirror string) (string, error) {
+	upstream, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid download URL %s: %w", rawURL, err)
+	}
+
+	if strings.Contains(mirror, "{") {
+		replacer := strings.NewReplacer(
+			"{host}", upstream.Host,
+			"{path}", strings.TrimPrefix(upstream.EscapedPath(), "/"),
+			"{filename}", path.Base(upstream.Path),
+		)
+		return replacer.Replace(mirror), nil
+	}
+
+	base, err := url.Parse(strings.TrimSuffix(mirror, "/"))
+	if err != nil || base.Host == "" {
+		return "", fmt.Errorf("invalid mirror URL: %s", mirror)
+	}
+
+	base.Path += upstream.Path
+	base.RawPath = ""
+	base.RawQuery = upstream.RawQuery
+
+	return base.String(), nil
+}
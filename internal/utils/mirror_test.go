@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestRewriteURL(t *testing.T) {
+	upstream := "https://github.com/adoptium/temurin21-binaries/releases/download/jdk-21.0.2%2B13/OpenJDK21U-jdk_x64_linux_hotspot_21.0.2_13.tar.gz"
+
+	tests := []struct {
+		mirror   string
+		expected string
+		hasError bool
+	}{
+		{
+			"https://nexus.example.com/repository/github/",
+			"https://nexus.example.com/repository/github/adoptium/temurin21-binaries/releases/download/jdk-21.0.2+13/OpenJDK21U-jdk_x64_linux_hotspot_21.0.2_13.tar.gz",
+			false,
+		},
+		{
+			"https://artifactory.example.com/jdks/{filename}",
+			"https://artifactory.example.com/jdks/OpenJDK21U-jdk_x64_linux_hotspot_21.0.2_13.tar.gz",
+			false,
+		},
+		{
+			"https://cache.example.com/{host}/{path}",
+			"https://cache.example.com/github.com/adoptium/temurin21-binaries/releases/download/jdk-21.0.2%2B13/OpenJDK21U-jdk_x64_linux_hotspot_21.0.2_13.tar.gz",
+			false,
+		},
+		{"not a url", "", true},
+	}
+
+	for _, test := range tests {
+		result, err := RewriteURL(upstream, test.mirror)
+		if test.hasError {
+			if err == nil {
+				t.Errorf("Expected error for mirror %s", test.mirror)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for mirror %s: %v", test.mirror, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("RewriteURL with mirror %s = %s, expected %s", test.mirror, result, test.expected)
+		}
+	}
+}
+
+func TestMirrorURLs(t *testing.T) {
+	urls, err := MirrorURLs("https://example.com/a/jdk.zip", []string{"https://m1.example.com", "https://m2.example.com/base"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"https://m1.example.com/a/jdk.zip",
+		"https://m2.example.com/base/a/jdk.zip",
+	}
+
+	if len(urls) != len(expected) {
+		t.Fatalf("Expected %d URLs, got %v", len(expected), urls)
+	}
+	for i := range expected {
+		if urls[i] != expected[i] {
+			t.Errorf("URL %d = %s, expected %s", i, urls[i], expected[i])
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Package version parses and compares Java version strings: JEP 223 versions such as
+// 21.0.2+13 or 25-ea+5, the legacy Java 8 notations 1.8.0_392 and 8u392, and
+// release names such as jdk-21.0.2+13 or jdk8u392-b08.
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxComponents is the number of version numbers kept: feature, interim, update and patch
+const maxComponents = 4
+
+var (
+	// jep223 matches $VNUM(-$PRE)?(\+$BUILD?(-$OPT)?)?
+	jep223 = regexp.MustCompile(`^(\d+(?:\.\d+)*)(?:-([a-zA-Z0-9]+))?(?:\+(\d*)(?:-([-a-zA-Z0-9.]+))?)?$`)
+	// legacyUpdate matches 8u392, 8u392-b08 and 8u412-b02-ea
+	legacyUpdate = regexp.MustCompile(`^(\d+)u(\d+)(?:-b(\d+))?(?:-(ea))?$`)
+	// legacyDotted matches 1.8.0, 1.8.0_392 and 1.8.0_392-b08
+	legacyDotted = regexp.MustCompile(`^1\.([1-8])\.0(?:_(\d+))?(?:-b(\d+))?(?:-(ea))?$`)
+)
+
+// Version is a parsed Java version
+type Version struct {
+	Major    int
+	Minor    int
+	Security int
+	Patch    int
+	Pre      string // Pre-release identifier, e.g. "ea"
+	Build    int
+
+	// components is the number of version numbers given, 0 meaning all of them
+	components int
+}
+
+// Parse parses a Java version string. A jdk- or jdk prefix, as used in release names, is ignored.
+func Parse(s string) (Version, error) {
+	trimmed := strings.TrimSpace(s)
+	if rest, ok := strings.CutPrefix(trimmed, "jdk-"); ok {
+		trimmed = rest
+	} else if rest, ok := strings.CutPrefix(trimmed, "jdk"); ok && rest != "" && rest[0] >= '0' && rest[0] <= '9' {
+		trimmed = rest
+	}
+
+	if m := legacyUpdate.FindStringSubmatch(trimmed); m != nil {
+		return legacy(m[1], m[2], m[3], m[4])
+	}
+	if m := legacyDotted.FindStringSubmatch(trimmed); m != nil {
+		return legacy(m[1], m[2], m[3], m[4])
+	}
+
+	m := jep223.FindStringSubmatch(trimmed)
+	if m == nil {
+		return Version{}, fmt.Errorf("invalid version: %q", s)
+	}
+
+	parts := strings.Split(m[1], ".")
+	if len(parts) > maxComponents {
+		return Version{}, fmt.Errorf("invalid version: %q has more than %d version numbers", s, maxComponents)
+	}
+
+	v := Version{Pre: m[2], components: len(parts)}
+	fields := v.fields()
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid version: %q", s)
+		}
+		*fields[i] = n
+	}
+	if m[3] != "" {
+		build, err := strconv.Atoi(m[3])
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid build number in %q", s)
+		}
+		v.Build = build
+	}
+
+	return v, nil
+}
+
+// legacy builds a version from the parts of a Java 8 style version, whose update
+// release is the security number of the JEP 223 scheme
+func legacy(major, update, build, pre string) (Version, error) {
+	v := Version{Pre: pre, components: 3}
+	for _, field := range []struct {
+		value  string
+		target *int
+	}{{major, &v.Major}, {update, &v.Security}, {build, &v.Build}} {
+		if field.value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(field.value)
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid version number: %s", field.value)
+		}
+		*field.target = n
+	}
+	return v, nil
+}
+
+func (v *Version) fields() []*int {
+	return []*int{&v.Major, &v.Minor, &v.Security, &v.Patch}
+}
+
+func (v Version) numbers() []int {
+	return []int{v.Major, v.Minor, v.Security, v.Patch}
+}
+
+// Precision returns how many version numbers were given, e.g. 2 for 17.0.
+// Versions that were not parsed have full precision.
+func (v Version) Precision() int {
+	if v.components == 0 {
+		return maxComponents
+	}
+	return v.components
+}
+
+// IsPreRelease reports whether the version is an early-access or other pre-release build
+func (v Version) IsPreRelease() bool {
+	return v.Pre != ""
+}
+
+// Compare returns -1, 0 or 1 depending on whether v is older, equal to or newer than other.
+// A GA build is newer than any pre-release of the same version.
+func (v Version) Compare(other Version) int {
+	if result := compareNumbers(v.numbers(), other.numbers()); result != 0 {
+		return result
+	}
+
+	switch {
+	case v.Pre == "" && other.Pre != "":
+		return 1
+	case v.Pre != "" && other.Pre == "":
+		return -1
+	case v.Pre != other.Pre:
+		return strings.Compare(v.Pre, other.Pre)
+	}
+
+	return compareInts(v.Build, other.Build)
+}
+
+// ComparePrefix compares the version numbers of v with those of bound, up to the
+// precision of bound. 17.0.8 compares equal to 17 and 17.0, and greater than 17.0.7.
+func (v Version) ComparePrefix(bound Version) int {
+	n := bound.Precision()
+	return compareNumbers(v.numbers()[:n], bound.numbers()[:n])
+}
+
+// HasPrefix reports whether v is a release of prefix: 17.0.8+7 is a release of 17,
+// 17.0 and 17.0.8, and of 17.0.8+7 but not 17.0.8+6
+func (v Version) HasPrefix(prefix Version) bool {
+	if v.ComparePrefix(prefix) != 0 {
+		return false
+	}
+	return prefix.Build == 0 || v.Build == prefix.Build
+}
+
+// Number returns the version number without pre-release and build, e.g. 17.0.8
+func (v Version) Number() string {
+	s := strconv.Itoa(v.Major)
+	if v.Minor > 0 || v.Security > 0 || v.Patch > 0 {
+		s += fmt.Sprintf(".%d.%d", v.Minor, v.Security)
+	}
+	if v.Patch > 0 {
+		s += fmt.Sprintf(".%d", v.Patch)
+	}
+	return s
+}
+
+// String returns the version in JEP 223 format, e.g. 21, 17.0.8+7 or 25-ea+5
+func (v Version) String() string {
+	s := v.Number()
+	if v.Pre != "" {
+		s += "-" + v.Pre
+	}
+	if v.Build > 0 {
+		s += "+" + strconv.Itoa(v.Build)
+	}
+	return s
+}
+
+func compareNumbers(a, b []int) int {
+	for i := range a {
+		if result := compareInts(a[i], b[i]); result != 0 {
+			return result
+		}
+	}
+	return 0
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
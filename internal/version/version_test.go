@@ -0,0 +1,173 @@
+package version
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input                         string
+		major, minor, security, patch int
+		pre                           string
+		build, precision              int
+	}{
+		{"21", 21, 0, 0, 0, "", 0, 1},
+		{"17.0", 17, 0, 0, 0, "", 0, 2},
+		{"17.0.8", 17, 0, 8, 0, "", 0, 3},
+		{"11.0.9.1", 11, 0, 9, 1, "", 0, 4},
+		{"21.0.2+13", 21, 0, 2, 0, "", 13, 3},
+		{"21.0.2+13-LTS", 21, 0, 2, 0, "", 13, 3},
+		{"17.0.8+7-LTS-123", 17, 0, 8, 0, "", 7, 3},
+		{"25-ea", 25, 0, 0, 0, "ea", 0, 1},
+		{"25-ea+5", 25, 0, 0, 0, "ea", 5, 1},
+		{"21.0.2+-internal", 21, 0, 2, 0, "", 0, 3},
+		{"jdk-21.0.2+13", 21, 0, 2, 0, "", 13, 3},
+		{"jdk-25+36-ea-beta", 25, 0, 0, 0, "", 36, 1},
+		{"1.8.0", 8, 0, 0, 0, "", 0, 3},
+		{"1.8.0_392", 8, 0, 392, 0, "", 0, 3},
+		{"1.8.0_392-b08", 8, 0, 392, 0, "", 8, 3},
+		{"8u392", 8, 0, 392, 0, "", 0, 3},
+		{"8u392-b08", 8, 0, 392, 0, "", 8, 3},
+		{"jdk8u392-b08", 8, 0, 392, 0, "", 8, 3},
+		{"jdk8u412-b02-ea", 8, 0, 412, 0, "ea", 2, 3},
+	}
+
+	for _, test := range tests {
+		v, err := Parse(test.input)
+		if err != nil {
+			t.Errorf("Parse(%s) failed: %v", test.input, err)
+			continue
+		}
+		if v.Major != test.major || v.Minor != test.minor || v.Security != test.security || v.Patch != test.patch ||
+			v.Pre != test.pre || v.Build != test.build || v.Precision() != test.precision {
+			t.Errorf("Parse(%s) = %+v (precision %d)", test.input, v, v.Precision())
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, input := range []string{"", "invalid", "v21", "21.", ".21", "21..0", "-ea", "21-", "21+x",
+		"1.2.3.4.5", "jdk-", "jdk-23.0.0-ea.01", "vm-22.3.3", "8u", "1.8.0_"} {
+		if v, err := Parse(input); err == nil {
+			t.Errorf("Parse(%s) = %+v, expected error", input, v)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"17.0.10", "17.0.8", 1},
+		{"8", "21", -1},
+		{"21", "21.0.0", 0},
+		{"21.0.1", "21.0.1.1", -1},
+		{"21.0.2+13", "21.0.2+7", 1},
+		{"25", "25-ea+36", 1},
+		{"25-ea+36", "25-ea+5", 1},
+		{"8u392", "1.8.0_392", 0},
+		{"8u402", "1.8.0_392-b08", 1},
+		{"jdk-21.0.2+13", "21.0.2+13", 0},
+	}
+
+	for _, test := range tests {
+		a, err := Parse(test.a)
+		if err != nil {
+			t.Fatalf("Parse(%s) failed: %v", test.a, err)
+		}
+		b, err := Parse(test.b)
+		if err != nil {
+			t.Fatalf("Parse(%s) failed: %v", test.b, err)
+		}
+		if result := a.Compare(b); result != test.expected {
+			t.Errorf("Compare(%s, %s) = %d, expected %d", test.a, test.b, result, test.expected)
+		}
+		if result := b.Compare(a); result != -test.expected {
+			t.Errorf("Compare(%s, %s) = %d, expected %d", test.b, test.a, result, -test.expected)
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	inputs := []string{"21", "17.0.10", "8", "17.0.8", "11.0.20", "25-ea"}
+	versions := make([]Version, len(inputs))
+	for i, input := range inputs {
+		v, err := Parse(input)
+		if err != nil {
+			t.Fatalf("Parse(%s) failed: %v", input, err)
+		}
+		versions[i] = v
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Compare(versions[j]) < 0
+	})
+
+	expected := []string{"8", "11.0.20", "17.0.8", "17.0.10", "21", "25-ea"}
+	for i, v := range versions {
+		if v.String() != expected[i] {
+			t.Errorf("Position %d: got %s, expected %s", i, v, expected[i])
+		}
+	}
+}
+
+func TestHasPrefix(t *testing.T) {
+	release, err := Parse("17.0.8+7")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		prefix   string
+		expected bool
+	}{
+		{"17", true},
+		{"17.0", true},
+		{"17.0.8", true},
+		{"17.0.8+7", true},
+		{"17.0.8+6", false},
+		{"17.0.9", false},
+		{"18", false},
+		{"17.0.8.0", true},
+		{"17.0.8.1", false},
+	}
+
+	for _, test := range tests {
+		prefix, err := Parse(test.prefix)
+		if err != nil {
+			t.Fatalf("Parse(%s) failed: %v", test.prefix, err)
+		}
+		if result := release.HasPrefix(prefix); result != test.expected {
+			t.Errorf("17.0.8+7 HasPrefix(%s) = %v, expected %v", test.prefix, result, test.expected)
+		}
+	}
+
+	java8, _ := Parse("1.8.0_392-b08")
+	update, _ := Parse("8u392")
+	if !java8.HasPrefix(update) {
+		t.Error("Expected 1.8.0_392-b08 to be a release of 8u392")
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := map[string]string{
+		"21":            "21",
+		"17.0.8":        "17.0.8",
+		"21.0.2+13-LTS": "21.0.2+13",
+		"25-ea+5":       "25-ea+5",
+		"8u392-b08":     "8.0.392+8",
+		"11.0.9.1":      "11.0.9.1",
+	}
+
+	for input, expected := range tests {
+		v, err := Parse(input)
+		if err != nil {
+			t.Fatalf("Parse(%s) failed: %v", input, err)
+		}
+		if v.String() != expected {
+			t.Errorf("Parse(%s).String() = %s, expected %s", input, v, expected)
+		}
+	}
+}
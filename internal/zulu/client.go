@@ -0,0 +1,248 @@
+package zulu
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/jdk-manager/internal/adoptium"
+	"github.com/jdk-manager/internal/httpclient"
+	"github.com/jdk-manager/internal/version"
+)
+
+const (
+	azulAPIBase = "https://api.azul.com/metadata/v1"
+)
+
+// Client handles communication with the Azul metadata API
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// Package represents a Zulu package returned by the metadata API
+type Package struct {
+	PackageUUID        string `json:"package_uuid"`
+	Name               string `json:"name"`
+	JavaVersion        []int  `json:"java_version"`
+	OpenJDKBuildNumber int    `json:"openjdk_build_number"`
+	Latest             bool   `json:"latest"`
+	DownloadURL        string `json:"download_url"`
+	DistroVersion      []int  `json:"distro_version"`
+	SHA256Hash         string `json:"sha256_hash"` // Only returned by the package details endpoint
+	Size               int64  `json:"size"`        // Only returned by the package details endpoint
+}
+
+// NewClient creates a new Azul metadata API client
+func NewClient() *Client {
+	return &Client{
+		httpClient: httpclient.New(),
+		baseURL:    azulAPIBase,
+	}
+}
+
+// SetHTTPClient replaces the client used for API requests, e.g. with a caching one
+func (c *Client) SetHTTPClient(client *http.Client) {
+	c.httpClient = client
+}
+
+// Vendor returns the distribution served by this client
+func (c *Client) Vendor() adoptium.Vendor {
+	return adoptium.Vendor{
+		Name:        "zulu",
+		DisplayName: "Azul Zulu",
+		Aliases:     []string{"azul"},
+		Homepage:    "https://www.azul.com/downloads",
+	}
+}
+
+// GetAvailableReleases returns the latest Zulu release of every major version
+// published for the current platform
+func (c *Client) GetAvailableReleases(ctx context.Context) ([]adoptium.Release, error) {
+	packages, err := c.searchPackages(ctx, "", adoptium.ImageTypeJDK, adoptium.CurrentPlatform())
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the newest package of each major version
+	latest := make(map[int]adoptium.VersionData)
+	for _, pkg := range packages {
+		versionData, ok := pkg.versionData()
+		if !ok {
+			continue
+		}
+		if current, exists := latest[versionData.Major]; !exists || versionData.Compare(current) > 0 {
+			latest[versionData.Major] = versionData
+		}
+	}
+
+	var releases []adoptium.Release
+	for _, versionData := range latest {
+		releases = append(releases, adoptium.Release{VersionData: versionData})
+	}
+
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].VersionData.Major > releases[j].VersionData.Major
+	})
+
+	return releases, nil
+}
+
+// GetDownloadInfo gets download information for a specific Zulu version
+func (c *Client) GetDownloadInfo(ctx context.Context, request adoptium.DownloadRequest) (*adoptium.DownloadInfo, error) {
+	requested, err := version.Parse(request.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version format: %s", request.Version)
+	}
+
+	// Azul publishes JDK and JRE packages only
+	imageType := request.ImageTypeOrDefault()
+	if imageType != adoptium.ImageTypeJDK && imageType != adoptium.ImageTypeJRE {
+		return nil, fmt.Errorf("Azul Zulu does not publish %s images", imageType)
+	}
+
+	platform := request.Platform()
+	packages, err := c.searchPackages(ctx, requested.Number(), imageType, platform)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pick the newest package matching the requested version
+	var best *Package
+	var bestVersion adoptium.VersionData
+	for i := range packages {
+		versionData, ok := packages[i].versionData()
+		if !ok || !versionData.Matches(request.Version) {
+			continue
+		}
+		if best == nil || versionData.Compare(bestVersion) > 0 {
+			best = &packages[i]
+			bestVersion = versionData
+		}
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("no suitable Zulu %s found for version %s on %s/%s", imageType, request.Version, c.getOSName(platform), platform.VendorArch())
+	}
+
+	// The search results do not carry checksums, the package details do
+	details, err := c.getPackageDetails(ctx, best.PackageUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adoptium.DownloadInfo{
+		URL:       best.DownloadURL,
+		Filename:  best.Name,
+		Size:      details.Size,
+		Vendor:    c.Vendor().Name,
+		Version:   bestVersion,
+		Checksum:  details.SHA256Hash,
+		ImageType: imageType,
+		Platform:  platform,
+	}, nil
+}
+
+// getPackageDetails fetches the full metadata of a single package
+func (c *Client) getPackageDetails(ctx context.Context, packageUUID string) (*Package, error) {
+	apiURL := fmt.Sprintf("%s/zulu/packages/%s", c.baseURL, url.PathEscape(packageUUID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch Zulu package details: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+	}
+
+	var details Package
+	if err := json.NewDecoder(resp.Body).Decode(&details); err != nil {
+		return nil, fmt.Errorf("failed to decode API response: %w", err)
+	}
+
+	return &details, nil
+}
+
+// searchPackages queries the metadata API for GA packages of a platform.
+// An empty version returns the latest package of every Java version.
+func (c *Client) searchPackages(ctx context.Context, version, packageType string, platform adoptium.Platform) ([]Package, error) {
+	query := url.Values{}
+	query.Set("os", c.getOSName(platform))
+	query.Set("arch", platform.VendorArch())
+	query.Set("archive_type", platform.ArchiveType())
+	query.Set("java_package_type", packageType)
+	query.Set("javafx_bundled", "false")
+	query.Set("release_status", "ga")
+	query.Set("availability_types", "CA")
+	query.Set("latest", "true")
+	query.Set("page_size", "1000")
+	if version != "" {
+		query.Set("java_version", version)
+	}
+
+	apiURL := fmt.Sprintf("%s/zulu/packages/?%s", c.baseURL, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch Zulu packages: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+	}
+
+	var packages []Package
+	if err := json.NewDecoder(resp.Body).Decode(&packages); err != nil {
+		return nil, fmt.Errorf("failed to decode API response: %w", err)
+	}
+
+	return packages, nil
+}
+
+// versionData converts the package's java_version array into VersionData
+func (p Package) versionData() (adoptium.VersionData, bool) {
+	if len(p.JavaVersion) == 0 {
+		return adoptium.VersionData{}, false
+	}
+
+	v := adoptium.VersionData{
+		Major: p.JavaVersion[0],
+		Build: p.OpenJDKBuildNumber,
+	}
+	if len(p.JavaVersion) > 1 {
+		v.Minor = p.JavaVersion[1]
+	}
+	if len(p.JavaVersion) > 2 {
+		v.Security = p.JavaVersion[2]
+	}
+
+	return v, true
+}
+
+// getOSName returns the OS name of a platform in Azul API format, picking the
+// Linux packages built against the platform's C library
+func (c *Client) getOSName(platform adoptium.Platform) string {
+	if platform.OS == "linux" {
+		if platform.LibC == adoptium.LibCMusl {
+			return "linux-musl"
+		}
+		return "linux-glibc"
+	}
+	return platform.VendorOS()
+}
@@ -0,0 +1,109 @@
+package zulu
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jdk-manager/internal/adoptium"
+)
+
+func TestGetAvailableReleases(t *testing.T) {
+	packages := []Package{
+		{Name: "zulu11.68.17-ca-jdk11.0.21-linux_x64.tar.gz", JavaVersion: []int{11, 0, 21}, OpenJDKBuildNumber: 9},
+		{Name: "zulu11.70.15-ca-jdk11.0.22-linux_x64.tar.gz", JavaVersion: []int{11, 0, 22}, OpenJDKBuildNumber: 7},
+		{Name: "zulu8.76.0.17-ca-jdk8.0.402-linux_x64.tar.gz", JavaVersion: []int{8, 0, 402}, OpenJDKBuildNumber: 6},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zulu/packages/" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Query().Get("java_package_type") != "jdk" || r.URL.Query().Get("release_status") != "ga" {
+			t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(packages)
+	}))
+	defer server.Close()
+
+	client := NewClient()
+	client.baseURL = server.URL
+
+	releases, err := client.GetAvailableReleases(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get releases: %v", err)
+	}
+
+	if len(releases) != 2 {
+		t.Fatalf("Expected 2 releases, got %d", len(releases))
+	}
+
+	if releases[0].VersionData.Major != 11 || releases[0].VersionData.Security != 22 {
+		t.Errorf("Expected latest 11 release to be 11.0.22, got %+v", releases[0].VersionData)
+	}
+
+	if releases[1].VersionData.Major != 8 || releases[1].VersionData.Security != 402 {
+		t.Errorf("Expected 8.0.402, got %+v", releases[1].VersionData)
+	}
+}
+
+func TestGetDownloadInfo(t *testing.T) {
+	packages := []Package{
+		{PackageUUID: "a", Name: "zulu11.68.17-ca-jdk11.0.21-linux_x64.tar.gz", JavaVersion: []int{11, 0, 21}, DownloadURL: "https://cdn.example.com/a.tar.gz"},
+		{PackageUUID: "b", Name: "zulu11.70.15-ca-jdk11.0.22-linux_x64.tar.gz", JavaVersion: []int{11, 0, 22}, DownloadURL: "https://cdn.example.com/b.tar.gz"},
+	}
+
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Checksums and sizes come from the package details
+		if uuid, ok := strings.CutPrefix(r.URL.Path, "/zulu/packages/"); ok && uuid != "" {
+			json.NewEncoder(w).Encode(Package{PackageUUID: uuid, SHA256Hash: "sha-" + uuid, Size: 1234})
+			return
+		}
+		if r.URL.Path != "/zulu/packages/" {
+			http.NotFound(w, r)
+			return
+		}
+		requested = append(requested, r.URL.Query().Get("java_version"))
+		json.NewEncoder(w).Encode(packages)
+	}))
+	defer server.Close()
+
+	client := NewClient()
+	client.baseURL = server.URL
+
+	info, err := client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "11"})
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+	if info.URL != "https://cdn.example.com/b.tar.gz" || info.Filename != "zulu11.70.15-ca-jdk11.0.22-linux_x64.tar.gz" {
+		t.Errorf("Expected newest package, got %+v", info)
+	}
+	if info.Checksum != "sha-b" || info.Size != 1234 {
+		t.Errorf("Expected checksum and size from package details, got %+v", info)
+	}
+
+	info, err = client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "11.0.21"})
+	if err != nil {
+		t.Fatalf("Failed to get download info: %v", err)
+	}
+	if info.URL != "https://cdn.example.com/a.tar.gz" {
+		t.Errorf("Expected 11.0.21 package, got %+v", info)
+	}
+
+	if len(requested) != 2 || requested[0] != "11" || requested[1] != "11.0.21" {
+		t.Errorf("Expected java_version to be passed to the API, got %v", requested)
+	}
+
+	if _, err := client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "11.0.9"}); err == nil {
+		t.Error("Expected error for unavailable version")
+	}
+
+	if _, err := client.GetDownloadInfo(context.Background(), adoptium.DownloadRequest{Version: "eleven"}); err == nil {
+		t.Error("Expected error for invalid version")
+	}
+}